@@ -0,0 +1,59 @@
+package card
+
+import "github.com/PudgeKim/go-holdem/errors/carderror"
+
+// BestHand는 주어진 카드들로 만들 수 있는 가장 좋은 5장과 그 세기
+type BestHand struct {
+	Cards []Card // 랭크 오름차순으로 정렬되어있음
+	Value HandValue
+}
+
+// GetBestHand는 5~7장의 카드 중 가장 좋은 5장의 조합을 리턴함
+// 플랍(5장)이나 턴(6장)에서도 현재 완성된 핸드를 보여줄 수 있음
+// 카드 수가 맞지 않거나 중복된 카드가 있으면 에러를 리턴
+func GetBestHand(cards []Card) (BestHand, error) {
+	return getBestHand(cards, Evaluate)
+}
+
+func getBestHand(cards []Card, evaluate func([]Card) HandValue) (BestHand, error) {
+	if err := validateCards(cards); err != nil {
+		return BestHand{}, err
+	}
+
+	best := evaluate(cards)
+
+	var allCombs [][]Card
+	makeAllCombinations(cards, []Card{}, &allCombs, 0, 0)
+
+	for _, comb := range allCombs {
+		if evaluate(comb) == best {
+			SortCards(comb)
+			return BestHand{Cards: comb, Value: best}, nil
+		}
+	}
+
+	// 전체 카드의 세기는 항상 어떤 5장 조합의 세기와 같으므로 여기까지 오지 않음
+	return BestHand{}, carderror.InvalidCard
+}
+
+func validateCards(cards []Card) error {
+	if len(cards) < 5 || len(cards) > 7 {
+		return carderror.InvalidCardCount
+	}
+	return validateUniqueCards(cards)
+}
+
+// 모든 카드가 올바른 카드이고 중복이 없는지 확인
+func validateUniqueCards(cards []Card) error {
+	seen := make(map[Card]bool, len(cards))
+	for _, c := range cards {
+		if !c.IsValid() {
+			return carderror.InvalidCard
+		}
+		if seen[c] {
+			return carderror.DuplicateCard
+		}
+		seen[c] = true
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package card
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+)
+
+func TestGetBestHand(t *testing.T) {
+	// 플랍 (5장)
+	cards = mustParseCards("Qs 6h Ad 6s 3c")
+	bestHand, err := GetBestHand(cards)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if bestHand.Value.Category() != OnePair || bestHand.Value.Primary() != Six {
+		t.Error("It should be OnePair of Sixes")
+	}
+	if len(bestHand.Cards) != 5 {
+		t.Error("BestHand should have 5 cards")
+	}
+
+	// 턴 (6장)
+	cards = append(cards, mustParseCards("6c")...)
+	bestHand, err = GetBestHand(cards)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	expected := mustParseCards("6h 6s 6c Qs Ad")
+	if !reflect.DeepEqual(bestHand.Cards, expected) {
+		t.Errorf("BestCard is wrong: %v", bestHand.Cards)
+	}
+	if bestHand.Value.Category() != Triple {
+		t.Error("It should be Triple")
+	}
+
+	// 리버 (7장)
+	cards = append(cards, mustParseCards("Qd")...)
+	bestHand, err = GetBestHand(cards)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	expected = mustParseCards("6h 6s 6c Qs Qd")
+	if !reflect.DeepEqual(bestHand.Cards, expected) {
+		t.Errorf("BestCard is wrong: %v", bestHand.Cards)
+	}
+	if bestHand.Value != Evaluate(expected) {
+		t.Error("Value should be same as evaluating BestHand's cards")
+	}
+}
+
+func TestGetBestHandInvalidInput(t *testing.T) {
+	_, err := GetBestHand(mustParseCards("Qs 6h Ad 6s"))
+	if err != carderror.InvalidCardCount {
+		t.Error("4 cards should return InvalidCardCount")
+	}
+
+	_, err = GetBestHand(newOrderedDeck()[:8])
+	if err != carderror.InvalidCardCount {
+		t.Error("8 cards should return InvalidCardCount")
+	}
+
+	_, err = GetBestHand(mustParseCards("Qs 6h Ad 6s Qs"))
+	if err != carderror.DuplicateCard {
+		t.Error("duplicate cards should return DuplicateCard")
+	}
+
+	_, err = GetBestHand([]Card{
+		{Symbol: Spade, Rank: Queen},
+		{Symbol: Heart, Rank: Six},
+		{Symbol: Diamond, Rank: Ace},
+		{Symbol: Spade, Rank: Six},
+		{Symbol: "Star", Rank: Two},
+	})
+	if err != carderror.InvalidCard {
+		t.Error("unknown symbol should return InvalidCard")
+	}
+
+	_, err = GetBestHand([]Card{
+		{Symbol: Spade, Rank: Queen},
+		{Symbol: Heart, Rank: Six},
+		{Symbol: Diamond, Rank: Ace},
+		{Symbol: Spade, Rank: Six},
+		{Symbol: Spade, Rank: None},
+	})
+	if err != carderror.InvalidCard {
+		t.Error("rank None should return InvalidCard")
+	}
+}
@@ -4,3 +4,16 @@ type Card struct {
 	Symbol Symbol
 	Rank   Rank
 }
+
+// IsValid는 카드의 무늬와 랭크가 실제 덱에 존재하는 값인지 확인함
+func (c Card) IsValid() bool {
+	if c.Rank < Two || c.Rank > Ace {
+		return false
+	}
+
+	switch c.Symbol {
+	case Spade, Heart, Diamond, Clover:
+		return true
+	}
+	return false
+}
@@ -17,4 +17,27 @@ const (
 	Queen
 	King
 	Ace
-)
\ No newline at end of file
+)
+
+var rankNames = map[Rank]string{
+	Two:   "2",
+	Three: "3",
+	Four:  "4",
+	Five:  "5",
+	Six:   "6",
+	Seven: "7",
+	Eight: "8",
+	Nine:  "9",
+	Ten:   "T",
+	Jack:  "J",
+	Queen: "Q",
+	King:  "K",
+	Ace:   "A",
+}
+
+func (r Rank) String() string {
+	if name, ok := rankNames[r]; ok {
+		return name
+	}
+	return "?"
+}
@@ -1,17 +1,45 @@
 package card
 
-import (
-	"math/rand"
-	"time"
-)
+import "github.com/PudgeKim/go-holdem/errors/carderror"
 
+// 덱의 마지막 카드가 맨 위 카드임 (GetCard, Deal 등은 뒤에서부터 꺼냄)
 type Deck []Card
 
-func NewDeck() *Deck {
+type deckConfig struct {
+	shuffler Shuffler
+	variant  Variant
+}
+
+type DeckOption func(*deckConfig)
+
+// WithShuffler는 덱을 섞을 때 사용할 Shuffler를 지정함 (nil이면 CryptoShuffler 사용)
+func WithShuffler(shuffler Shuffler) DeckOption {
+	return func(c *deckConfig) {
+		if shuffler != nil {
+			c.shuffler = shuffler
+		}
+	}
+}
+
+// WithVariant는 덱의 종류를 지정함 (ShortDeck이면 2~5를 뺀 36장)
+func WithVariant(variant Variant) DeckOption {
+	return func(c *deckConfig) {
+		c.variant = variant
+	}
+}
+
+func NewDeck(opts ...DeckOption) *Deck {
+	config := deckConfig{
+		shuffler: NewCryptoShuffler(),
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
 	var d Deck
 	symbols := []Symbol{Spade, Heart, Diamond, Clover}
 
-	for i := 2; i < 15; i++ {
+	for i := int(config.variant.minRank()); i < 15; i++ {
 		for j := 0; j < 4; j++ {
 			c := Card{
 				Symbol: symbols[j],
@@ -21,20 +49,68 @@ func NewDeck() *Deck {
 		}
 	}
 
-	d.shuffle()
+	config.shuffler.Shuffle(d)
+	return &d
+}
+
+// NewDeckFromOrder는 섞지 않고 주어진 순서대로 카드가 나오는 덱을 만듬
+// cards[0]이 가장 먼저 나오는 카드이며 테스트에서 원하는 카드를 나눠주기 위해 사용
+func NewDeckFromOrder(cards []Card) *Deck {
+	d := make(Deck, len(cards))
+	for i, c := range cards {
+		d[len(cards)-1-i] = c
+	}
 	return &d
 }
 
-func (d *Deck) shuffle() {
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(*d), func(i, j int) {
-		(*d)[i], (*d)[j] = (*d)[j], (*d)[i]
-	})
+// Remaining은 덱에 남아있는 카드 수를 리턴
+func (d *Deck) Remaining() int {
+	return len(*d)
 }
 
-func (d *Deck) GetCard() Card {
+func (d *Deck) GetCard() (Card, error) {
+	if len(*d) == 0 {
+		return Card{}, carderror.EmptyDeck
+	}
+
 	lastIdx := len(*d) - 1
 	lastCard := (*d)[lastIdx]
 	*d = (*d)[:lastIdx]
-	return lastCard
+	return lastCard, nil
+}
+
+// Deal은 맨 위에서부터 n장을 꺼내서 나오는 순서대로 리턴
+// 남은 카드가 n장보다 적으면 덱은 그대로 두고 에러를 리턴함
+func (d *Deck) Deal(n int) ([]Card, error) {
+	if n < 0 || n > len(*d) {
+		return nil, carderror.NotEnoughCards
+	}
+
+	cards := make([]Card, n)
+	for i := range cards {
+		cards[i], _ = d.GetCard()
+	}
+	return cards, nil
+}
+
+// Burn은 맨 위 카드 한장을 버림
+func (d *Deck) Burn() error {
+	_, err := d.GetCard()
+	return err
+}
+
+// Peek은 덱에서 꺼내지 않고 다음에 나올 n장을 순서대로 리턴 (테스트용)
+func (d *Deck) Peek(n int) []Card {
+	if n <= 0 {
+		return nil
+	}
+	if n > len(*d) {
+		n = len(*d)
+	}
+
+	cards := make([]Card, 0, n)
+	for i := len(*d) - 1; i >= len(*d)-n; i-- {
+		cards = append(cards, (*d)[i])
+	}
+	return cards
 }
@@ -0,0 +1,100 @@
+package card
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+)
+
+func TestNewDeck(t *testing.T) {
+	d := NewDeck()
+	if len(*d) != 52 {
+		t.Error("deck should have 52 cards")
+	}
+
+	seen := make(map[Card]bool)
+	for _, c := range *d {
+		if seen[c] {
+			t.Errorf("%s appears twice", c)
+		}
+		seen[c] = true
+	}
+}
+
+func TestSeededShuffler(t *testing.T) {
+	d1 := NewDeck(WithShuffler(NewSeededShuffler(42)))
+	d2 := NewDeck(WithShuffler(NewSeededShuffler(42)))
+	if !reflect.DeepEqual(d1, d2) {
+		t.Error("same seed should make same deck")
+	}
+
+	d3 := NewDeck(WithShuffler(NewSeededShuffler(43)))
+	if reflect.DeepEqual(d1, d3) {
+		t.Error("different seed should make different deck")
+	}
+
+	// 같은 Shuffler로 두번 섞으면 다음 순서가 나와야함
+	shuffler := NewSeededShuffler(42)
+	NewDeck(WithShuffler(shuffler))
+	if reflect.DeepEqual(d1, NewDeck(WithShuffler(shuffler))) {
+		t.Error("second deck from same shuffler should be different")
+	}
+}
+
+func TestCryptoShuffler(t *testing.T) {
+	d1 := NewDeck(WithShuffler(NewCryptoShuffler()))
+	d2 := NewDeck(WithShuffler(NewCryptoShuffler()))
+	if reflect.DeepEqual(d1, d2) {
+		t.Error("two crypto shuffled decks should be different")
+	}
+}
+
+func TestDeal(t *testing.T) {
+	d := NewDeckFromOrder(mustParseCards("As Kd 7c 2h 9s"))
+	if d.Remaining() != 5 {
+		t.Error("deck should have 5 cards")
+	}
+	if FormatCards(d.Peek(2)) != "As Kd" || d.Remaining() != 5 {
+		t.Error("Peek should return top cards without removing them")
+	}
+	if len(d.Peek(0)) != 0 || len(d.Peek(-1)) != 0 {
+		t.Error("Peek with non-positive count should return no cards")
+	}
+
+	c, err := d.GetCard()
+	if err != nil || c.String() != "As" {
+		t.Error("first card should be As")
+	}
+	if err := d.Burn(); err != nil {
+		t.Error(err.Error())
+	}
+
+	cards, err := d.Deal(2)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if FormatCards(cards) != "7c 2h" {
+		t.Errorf("expected [7c 2h] but got [%s]", FormatCards(cards))
+	}
+
+	if _, err := d.Deal(2); err != carderror.NotEnoughCards {
+		t.Error("it should return NotEnoughCards")
+	}
+	if d.Remaining() != 1 {
+		t.Error("failed Deal should not remove cards")
+	}
+
+	if _, err := d.GetCard(); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := d.GetCard(); err != carderror.EmptyDeck {
+		t.Error("it should return EmptyDeck")
+	}
+	if err := d.Burn(); err != carderror.EmptyDeck {
+		t.Error("it should return EmptyDeck")
+	}
+	if len(d.Peek(3)) != 0 {
+		t.Error("Peek on empty deck should return no cards")
+	}
+}
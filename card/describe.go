@@ -0,0 +1,137 @@
+package card
+
+import "strings"
+
+// Locale은 핸드 설명에 사용할 언어
+type Locale string
+
+const (
+	English Locale = "en"
+	Korean  Locale = "ko"
+)
+
+var rankWords = map[Rank]string{
+	Two:   "Two",
+	Three: "Three",
+	Four:  "Four",
+	Five:  "Five",
+	Six:   "Six",
+	Seven: "Seven",
+	Eight: "Eight",
+	Nine:  "Nine",
+	Ten:   "Ten",
+	Jack:  "Jack",
+	Queen: "Queen",
+	King:  "King",
+	Ace:   "Ace",
+}
+
+var englishCategories = map[HandsRank]string{
+	HighCard:           "High Card",
+	OnePair:            "One Pair",
+	TwoPair:            "Two Pair",
+	Triple:             "Three of a Kind",
+	Straight:           "Straight",
+	Flush:              "Flush",
+	FullHouse:          "Full House",
+	FourCard:           "Four of a Kind",
+	StraightFlush:      "Straight Flush",
+	RoyalStraightFlush: "Royal Flush",
+}
+
+var koreanCategories = map[HandsRank]string{
+	HighCard:           "하이카드",
+	OnePair:            "원페어",
+	TwoPair:            "투페어",
+	Triple:             "트리플",
+	Straight:           "스트레이트",
+	Flush:              "플러시",
+	FullHouse:          "풀하우스",
+	FourCard:           "포카드",
+	StraightFlush:      "스트레이트 플러시",
+	RoyalStraightFlush: "로열 스트레이트 플러시",
+}
+
+// Describe는 사람이 읽을 수 있는 핸드 설명을 리턴함
+// 지원하지 않는 locale이면 영어로 설명함
+//
+// 예: "Full House, Kings full of Threes", "풀하우스 (K 풀 3)"
+func (v HandValue) Describe(locale Locale) string {
+	if _, ok := englishCategories[v.Category()]; !ok {
+		return ""
+	}
+
+	if locale == Korean {
+		return v.describeKorean()
+	}
+	return v.describeEnglish()
+}
+
+func (v HandValue) describeEnglish() string {
+	category := englishCategories[v.Category()]
+	primary, secondary := v.Primary(), v.Secondary()
+
+	var s string
+	switch v.Category() {
+	case RoyalStraightFlush:
+		return category
+	case HighCard, Flush, Straight, StraightFlush:
+		s = category + ", " + rankWords[primary] + "-high"
+	case TwoPair:
+		s = category + ", " + pluralRank(primary) + " and " + pluralRank(secondary)
+	case FullHouse:
+		return category + ", " + pluralRank(primary) + " full of " + pluralRank(secondary)
+	default:
+		s = category + ", " + pluralRank(primary)
+	}
+
+	kickers := v.Kickers()
+	if len(kickers) == 0 {
+		return s
+	}
+
+	names := make([]string, len(kickers))
+	for i, r := range kickers {
+		names[i] = r.String()
+	}
+	if len(kickers) == 1 {
+		return s + " (" + names[0] + " kicker)"
+	}
+	return s + " (" + strings.Join(names, ", ") + " kickers)"
+}
+
+func (v HandValue) describeKorean() string {
+	category := koreanCategories[v.Category()]
+	primary, secondary := v.Primary().String(), v.Secondary().String()
+
+	var details []string
+	switch v.Category() {
+	case RoyalStraightFlush:
+		return category
+	case HighCard, Flush, Straight, StraightFlush:
+		details = append(details, primary+" 하이")
+	case TwoPair:
+		details = append(details, primary, secondary)
+	case FullHouse:
+		return category + " (" + primary + " 풀 " + secondary + ")"
+	default:
+		details = append(details, primary)
+	}
+
+	if kickers := v.Kickers(); len(kickers) > 0 {
+		names := make([]string, len(kickers))
+		for i, r := range kickers {
+			names[i] = r.String()
+		}
+		details = append(details, "키커 "+strings.Join(names, " "))
+	}
+	return category + " (" + strings.Join(details, ", ") + ")"
+}
+
+// 예: Threes, Sixes
+func pluralRank(r Rank) string {
+	if r == Six {
+		return rankWords[r] + "es"
+	}
+	return rankWords[r] + "s"
+}
@@ -0,0 +1,40 @@
+package card
+
+import "testing"
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		hand    string
+		english string
+		korean  string
+	}{
+		{"As Kh 9s 7h 4d", "High Card, Ace-high (K, 9, 7, 4 kickers)", "하이카드 (A 하이, 키커 K 9 7 4)"},
+		{"7s 7h Qs Jh 4s 3d 2c", "One Pair, Sevens (Q, J, 4 kickers)", "원페어 (7, 키커 Q J 4)"},
+		{"As Ah Ks Kh 9s 3h 2c", "Two Pair, Aces and Kings (9 kicker)", "투페어 (A, K, 키커 9)"},
+		{"6s 6h 6d Ah 2d", "Three of a Kind, Sixes (A, 2 kickers)", "트리플 (6, 키커 A 2)"},
+		{"5s 4h 3s 2h Ad", "Straight, Five-high", "스트레이트 (5 하이)"},
+		{"As Ks 9s 7s 3s", "Flush, Ace-high (K, 9, 7, 3 kickers)", "플러시 (A 하이, 키커 K 9 7 3)"},
+		{"Ks Kh Kd 3h 3d", "Full House, Kings full of Threes", "풀하우스 (K 풀 3)"},
+		{"9s 9h 9d 9c 2d", "Four of a Kind, Nines (2 kicker)", "포카드 (9, 키커 2)"},
+		{"5h 6h 7h 8h 9h", "Straight Flush, Nine-high", "스트레이트 플러시 (9 하이)"},
+		{"Ts Js Qs Ks As", "Royal Flush", "로열 스트레이트 플러시"},
+	}
+
+	for _, tt := range tests {
+		v := Evaluate(mustParseCards(tt.hand))
+		if res := v.Describe(English); res != tt.english {
+			t.Errorf("%s: expected %q but got %q", tt.hand, tt.english, res)
+		}
+		if res := v.Describe(Korean); res != tt.korean {
+			t.Errorf("%s: expected %q but got %q", tt.hand, tt.korean, res)
+		}
+	}
+
+	v := Evaluate(mustParseCards("Ks Kh Kd 3h 3d"))
+	if v.Describe(Locale("fr")) != v.Describe(English) {
+		t.Error("unsupported locale should fall back to English")
+	}
+	if HandValue(0).Describe(English) != "" {
+		t.Error("zero HandValue should have no description")
+	}
+}
@@ -0,0 +1,188 @@
+package card
+
+import "github.com/PudgeKim/go-holdem/errors/carderror"
+
+type DrawType int
+
+const (
+	FlushDraw             DrawType = iota + 1 // 같은 무늬 4장
+	OpenEndedStraightDraw                     // 스트레이트를 완성하는 랭크가 2개 이상 (양방향, 더블 거츠샷)
+	Gutshot                                   // 스트레이트를 완성하는 랭크가 1개
+	BackdoorFlushDraw                         // 플랍에서 같은 무늬 3장 (턴, 리버 모두 같은 무늬가 나와야함)
+	BackdoorStraightDraw                      // 플랍에서 턴, 리버 두 장이 더 있어야 스트레이트가 완성됨
+	Overcards                                 // 두 홀카드가 모두 보드의 가장 높은 카드보다 높음
+)
+
+var drawTypeNames = map[DrawType]string{
+	FlushDraw:             "FlushDraw",
+	OpenEndedStraightDraw: "OpenEndedStraightDraw",
+	Gutshot:               "Gutshot",
+	BackdoorFlushDraw:     "BackdoorFlushDraw",
+	BackdoorStraightDraw:  "BackdoorStraightDraw",
+	Overcards:             "Overcards",
+}
+
+func (d DrawType) String() string {
+	if name, ok := drawTypeNames[d]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// DrawAnalysis는 홀카드와 플랍/턴 보드로 만들 수 있는 드로우들
+type DrawAnalysis struct {
+	Current     HandValue  // 지금 완성된 핸드
+	Draws       []DrawType // 해당되는 드로우들 (위에 선언된 순서)
+	Outs        []Card     // 다음 한장으로 족보가 올라가는 카드들 (랭크 오름차순, 같은 랭크는 s, h, d, c 순)
+	Probability float64    // 리버까지 아웃 중 한장 이상이 나올 확률 (0~1)
+}
+
+// AnalyzeDraws는 홀카드 2장과 보드 3~4장으로 현재 드로우와 아웃을 계산함
+//
+// 아웃은 다음 한장이 나왔을 때 족보가 올라가는 카드이며
+// 보드만으로 같은 족보가 되는 카드(예: 보드에 페어가 생겨서 투페어가 되는 경우)는 제외함
+// 플랍에서 Probability는 턴이나 리버에 아웃이 한장 이상 나올 확률이고
+// 두 장이 모두 필요한 백도어 드로우는 확률에 포함되지 않음
+func AnalyzeDraws(hole []Card, board []Card) (*DrawAnalysis, error) {
+	if len(hole) != 2 {
+		return nil, carderror.InvalidHoleCards
+	}
+	if len(board) != 3 && len(board) != 4 {
+		return nil, carderror.InvalidDrawBoard
+	}
+
+	known := append(append([]Card{}, hole...), board...)
+	if err := validateUniqueCards(known); err != nil {
+		return nil, err
+	}
+
+	current := Evaluate(known)
+	analysis := &DrawAnalysis{Current: current}
+
+	var holeMask, boardMask uint16
+	var holeSuits, allSuits [4]uint16
+	for _, c := range hole {
+		holeMask |= rankBit(c.Rank)
+		holeSuits[suitIndex(c.Symbol)] |= rankBit(c.Rank)
+		allSuits[suitIndex(c.Symbol)] |= rankBit(c.Rank)
+	}
+	for _, c := range board {
+		boardMask |= rankBit(c.Rank)
+		allSuits[suitIndex(c.Symbol)] |= rankBit(c.Rank)
+	}
+	allMask := holeMask | boardMask
+
+	if current.Category() < Flush {
+		for i, mask := range allSuits {
+			if holeSuits[i] == 0 {
+				continue
+			}
+			if bitCountTable[mask] == 4 {
+				analysis.Draws = append(analysis.Draws, FlushDraw)
+				break
+			}
+		}
+	}
+
+	var straightDraw bool
+	if current.Category() < Straight {
+		completing := 0
+		for r := Two; r <= Ace; r++ {
+			if makesStraight(allMask|rankBit(r), boardMask|rankBit(r)) {
+				completing++
+			}
+		}
+
+		switch {
+		case completing >= 2:
+			analysis.Draws = append(analysis.Draws, OpenEndedStraightDraw)
+			straightDraw = true
+		case completing == 1:
+			analysis.Draws = append(analysis.Draws, Gutshot)
+			straightDraw = true
+		}
+	}
+
+	// 백도어 드로우는 턴과 리버가 모두 남은 플랍에서만 의미가 있음
+	if len(board) == 3 {
+		if current.Category() < Flush {
+			for i, mask := range allSuits {
+				if holeSuits[i] != 0 && bitCountTable[mask] == 3 {
+					analysis.Draws = append(analysis.Draws, BackdoorFlushDraw)
+					break
+				}
+			}
+		}
+
+		if current.Category() < Straight && !straightDraw && hasBackdoorStraight(allMask, boardMask) {
+			analysis.Draws = append(analysis.Draws, BackdoorStraightDraw)
+		}
+	}
+
+	if bitCountTable[holeMask] == 2 && lowestRank(holeMask) > highestRank(boardMask) {
+		analysis.Draws = append(analysis.Draws, Overcards)
+	}
+
+	seen := make(map[Card]bool, len(known))
+	for _, c := range known {
+		seen[c] = true
+	}
+
+	with := append(append([]Card{}, known...), Card{})
+	boardWith := append(append([]Card{}, board...), Card{})
+	for r := Two; r <= Ace; r++ {
+		for _, s := range []Symbol{Spade, Heart, Diamond, Clover} {
+			c := Card{Symbol: s, Rank: r}
+			if seen[c] {
+				continue
+			}
+
+			with[len(with)-1] = c
+			boardWith[len(boardWith)-1] = c
+			improved := Evaluate(with).Category()
+			if improved > current.Category() && improved > Evaluate(boardWith).Category() {
+				analysis.Outs = append(analysis.Outs, c)
+			}
+		}
+	}
+
+	unseen := 52 - len(known)
+	analysis.Probability = hitProbability(len(analysis.Outs), unseen, 5-len(board))
+	return analysis, nil
+}
+
+// 홀카드를 사용해서 보드만으로 만들 수 있는 것보다 높은 스트레이트가 되는지
+func makesStraight(allMask, boardMask uint16) bool {
+	high := straightHighTable[allMask]
+	return high != 0 && high > straightHighTable[boardMask]
+}
+
+func hasBackdoorStraight(allMask, boardMask uint16) bool {
+	for r1 := Two; r1 <= Ace; r1++ {
+		for r2 := r1 + 1; r2 <= Ace; r2++ {
+			b := rankBit(r1) | rankBit(r2)
+			if makesStraight(allMask|b, boardMask|b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func lowestRank(mask uint16) Rank {
+	for r := Two; r <= Ace; r++ {
+		if mask&rankBit(r) != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// 남은 unseen장 중 outs장이 아웃일 때 draws장을 받아서 한장 이상 아웃이 나올 확률
+func hitProbability(outs, unseen, draws int) float64 {
+	miss := 1.0
+	for i := 0; i < draws; i++ {
+		miss *= float64(unseen-outs-i) / float64(unseen-i)
+	}
+	return 1 - miss
+}
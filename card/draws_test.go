@@ -0,0 +1,97 @@
+package card
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+)
+
+func TestAnalyzeDraws(t *testing.T) {
+	tests := []struct {
+		name        string
+		hole        string
+		board       string
+		draws       []DrawType
+		outs        int
+		probability float64
+	}{
+		{
+			name:        "nut flush draw with overcards",
+			hole:        "Ah Kh",
+			board:       "Qh 7h 2c",
+			draws:       []DrawType{FlushDraw, BackdoorStraightDraw, Overcards},
+			outs:        15, // 하트 9장 + A 3장 + K 3장
+			probability: 1 - float64(32*31)/float64(47*46),
+		},
+		{
+			name:        "open-ended straight draw on the turn",
+			hole:        "8s 9d",
+			board:       "Tc Jh 2s 2h",
+			draws:       []DrawType{OpenEndedStraightDraw},
+			outs:        14, // 7, Q로 스트레이트 8장 + 8, 9로 투페어 6장 (T, J는 보드만으로도 투페어)
+			probability: 14.0 / 46,
+		},
+		{
+			name:        "wheel gutshot",
+			hole:        "As 5d",
+			board:       "3c 4h 9s",
+			draws:       []DrawType{Gutshot},
+			outs:        10, // 2로 스트레이트 4장 + A, 5로 원페어 6장 (3, 4, 9는 보드 페어)
+			probability: 1 - float64(37*36)/float64(47*46),
+		},
+		{
+			name:        "straight on board alone is not a draw",
+			hole:        "2c 2d",
+			board:       "9s Th Jc Qd",
+			draws:       nil,
+			outs:        14, // 2로 트리플 2장 + 9, T, J, Q로 투페어 12장 (K, 8은 보드만으로 스트레이트)
+			probability: 14.0 / 46,
+		},
+	}
+
+	for _, tt := range tests {
+		analysis, err := AnalyzeDraws(mustParseCards(tt.hole), mustParseCards(tt.board))
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err.Error())
+		}
+		if !reflect.DeepEqual(analysis.Draws, tt.draws) {
+			t.Errorf("%s: expected draws %v but got %v", tt.name, tt.draws, analysis.Draws)
+		}
+		if len(analysis.Outs) != tt.outs {
+			t.Errorf("%s: expected %d outs but got %d (%s)", tt.name, tt.outs, len(analysis.Outs), FormatCards(analysis.Outs))
+		}
+		if math.Abs(analysis.Probability-tt.probability) > 1e-9 {
+			t.Errorf("%s: expected probability %f but got %f", tt.name, tt.probability, analysis.Probability)
+		}
+	}
+}
+
+func TestAnalyzeDrawsOuts(t *testing.T) {
+	analysis, err := AnalyzeDraws(mustParseCards("Js Ts"), mustParseCards("9s 8d 2s Kh"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// 스페이드 9장, 7과 Q 중 스페이드가 아닌 6장, J 3장, T 3장
+	expected := mustParseCards("3s 4s 5s 6s 7s 7h 7d 7c 8s Th Td Tc Jh Jd Jc Qs Qh Qd Qc Ks As")
+	if !reflect.DeepEqual(analysis.Outs, expected) {
+		t.Errorf("expected outs %s but got %s", FormatCards(expected), FormatCards(analysis.Outs))
+	}
+	if analysis.Current.Category() != HighCard {
+		t.Error("current hand should be HighCard")
+	}
+}
+
+func TestAnalyzeDrawsWithInvalidCards(t *testing.T) {
+	if _, err := AnalyzeDraws(mustParseCards("As"), mustParseCards("2c 3c 4c")); err != carderror.InvalidHoleCards {
+		t.Error("it should return InvalidHoleCards")
+	}
+	if _, err := AnalyzeDraws(mustParseCards("As Ks"), mustParseCards("2c 3c 4c 5c 6c")); err != carderror.InvalidDrawBoard {
+		t.Error("it should return InvalidDrawBoard")
+	}
+	if _, err := AnalyzeDraws(mustParseCards("As Ks"), mustParseCards("As 3c 4c")); err != carderror.DuplicateCard {
+		t.Error("it should return DuplicateCard")
+	}
+}
@@ -0,0 +1,155 @@
+package card
+
+// 랭크 비트마스크 (Two가 0번 비트, Ace가 12번 비트)를 인덱스로 하는 룩업 테이블들
+// 13개의 랭크로 만들 수 있는 모든 마스크(8192개)에 대해 미리 계산해둠
+var (
+	// 마스크 안에 포함된 가장 높은 스트레이트의 하이카드 (없으면 0)
+	straightHighTable [1 << 13]Rank
+
+	// 마스크 안에서 높은 순으로 최대 5개의 랭크를 4비트씩 묶은 값
+	// 예를 들어 A, K, 9만 있다면 A<<16 | K<<12 | 9<<8
+	topFiveTable [1 << 13]uint32
+
+	// 마스크에 포함된 랭크의 개수
+	bitCountTable [1 << 13]uint8
+)
+
+func init() {
+	for mask := 0; mask < len(topFiveTable); mask++ {
+		var packed uint32
+		var n uint8
+
+		for r := Ace; r >= Two; r-- {
+			if mask&int(rankBit(r)) == 0 {
+				continue
+			}
+			if n < 5 {
+				packed |= uint32(r) << (rankBits * (4 - uint32(n)))
+			}
+			n++
+		}
+
+		topFiveTable[mask] = packed
+		bitCountTable[mask] = n
+		straightHighTable[mask] = findStraightHigh(uint16(mask))
+		shortDeckStraightHighTable[mask] = findShortDeckStraightHigh(uint16(mask))
+	}
+}
+
+func rankBit(r Rank) uint16 {
+	return 1 << uint(r-Two)
+}
+
+// 위에서부터 5장씩 연속된 랭크가 있는지 확인하고
+// 마지막으로 A, 2, 3, 4, 5 스트레이트를 확인함
+func findStraightHigh(mask uint16) Rank {
+	for high := Ace; high >= Six; high-- {
+		window := uint16(0x1f) << uint(high-Six)
+		if mask&window == window {
+			return high
+		}
+	}
+
+	wheel := rankBit(Ace) | rankBit(Two) | rankBit(Three) | rankBit(Four) | rankBit(Five)
+	if mask&wheel == wheel {
+		return Five
+	}
+	return 0
+}
+
+// 마스크에서 높은 순으로 n개의 랭크를 4비트씩 묶어서 오른쪽 정렬한 값을 리턴
+func topRanks(mask uint16, n uint) uint32 {
+	return topFiveTable[mask] >> (rankBits * (5 - n))
+}
+
+func highestRank(mask uint16) Rank {
+	return Rank(topRanks(mask, 1))
+}
+
+func suitIndex(s Symbol) int {
+	switch s {
+	case Spade:
+		return 0
+	case Heart:
+		return 1
+	case Diamond:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// Evaluate는 5~7장의 카드로 만들 수 있는 가장 좋은 핸드의 세기를 리턴함
+// 조합을 만들지 않고 룩업 테이블만 이용하기 때문에 메모리 할당이 없음
+// 카드 수나 중복 여부는 검사하지 않으므로 올바른 카드들이 들어온다고 가정함
+func Evaluate(cards []Card) HandValue {
+	return evaluate(cards, &straightHighTable)
+}
+
+// straights는 랭크 마스크별 가장 높은 스트레이트의 하이카드 테이블 (덱 종류마다 다름)
+func evaluate(cards []Card, straights *[1 << 13]Rank) HandValue {
+	var suitMasks [4]uint16
+	for _, c := range cards {
+		suitMasks[suitIndex(c.Symbol)] |= rankBit(c.Rank)
+	}
+
+	// 7장 이하에서는 플러시가 있으면 포카드나 풀하우스가 나올 수 없음
+	for _, mask := range suitMasks {
+		if bitCountTable[mask] < 5 {
+			continue
+		}
+
+		if high := straights[mask]; high != 0 {
+			if high == Ace {
+				return newHandValue(RoyalStraightFlush, uint32(high)<<16)
+			}
+			return newHandValue(StraightFlush, uint32(high)<<16)
+		}
+		return newHandValue(Flush, topFiveTable[mask])
+	}
+
+	// 무늬별 마스크를 더해가며 랭크마다 몇 장씩 있는지를 비트 단위로 셈
+	// ones, twos, fours는 각 랭크의 개수를 이진수로 나타냈을 때의 각 자리 비트
+	var ones, twos, fours uint16
+	for _, mask := range suitMasks {
+		carry := ones & mask
+		ones ^= mask
+		fours |= twos & carry
+		twos ^= carry
+	}
+
+	all := ones | twos | fours
+	quads := fours
+	trips := ones & twos
+	pairs := twos &^ ones
+
+	switch {
+	case quads != 0:
+		quad := highestRank(quads)
+		return newHandValue(FourCard, uint32(quad)<<16|topRanks(all&^rankBit(quad), 1)<<12)
+
+	case trips != 0 && bitCountTable[trips]+bitCountTable[pairs] >= 2:
+		triple := highestRank(trips)
+		pair := highestRank(trips&^rankBit(triple) | pairs)
+		return newHandValue(FullHouse, uint32(triple)<<16|uint32(pair)<<12)
+
+	case straights[all] != 0:
+		return newHandValue(Straight, uint32(straights[all])<<16)
+
+	case trips != 0:
+		triple := highestRank(trips)
+		return newHandValue(Triple, uint32(triple)<<16|topRanks(all&^rankBit(triple), 2)<<8)
+
+	case bitCountTable[pairs] >= 2:
+		high := highestRank(pairs)
+		low := highestRank(pairs &^ rankBit(high))
+		kickers := all &^ (rankBit(high) | rankBit(low))
+		return newHandValue(TwoPair, uint32(high)<<16|uint32(low)<<12|topRanks(kickers, 1)<<8)
+
+	case pairs != 0:
+		pair := highestRank(pairs)
+		return newHandValue(OnePair, uint32(pair)<<16|topRanks(all&^rankBit(pair), 3)<<4)
+	}
+
+	return newHandValue(HighCard, topFiveTable[all])
+}
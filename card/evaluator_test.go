@@ -0,0 +1,184 @@
+package card
+
+import (
+	"flag"
+	"math/rand"
+	"testing"
+)
+
+var exhaustive = flag.Bool("exhaustive", false, "evaluate all 133M seven-card hands")
+
+func newOrderedDeck() []Card {
+	var d []Card
+	for _, s := range []Symbol{Spade, Heart, Diamond, Clover} {
+		for r := Two; r <= Ace; r++ {
+			d = append(d, Card{Symbol: s, Rank: r})
+		}
+	}
+	return d
+}
+
+func randomHands(n int, size int, seed int64) [][]Card {
+	r := rand.New(rand.NewSource(seed))
+	deck := newOrderedDeck()
+	hands := make([][]Card, n)
+
+	for i := range hands {
+		r.Shuffle(len(deck), func(a, b int) { deck[a], deck[b] = deck[b], deck[a] })
+		hand := make([]Card, size)
+		copy(hand, deck[:size])
+		hands[i] = hand
+	}
+	return hands
+}
+
+func TestEvaluate(t *testing.T) {
+	cards = mustParseCards("Qs 6h Ad Ks 6s 3c 2c")
+	expected := newHandValue(OnePair, uint32(Six)<<16|uint32(Ace)<<12|uint32(King)<<8|uint32(Queen)<<4)
+	if v := Evaluate(cards); v != expected {
+		t.Errorf("expected %x but got %x", expected, v)
+	}
+
+	cards = mustParseCards("Ah 2h 3h 4h 5h Kh Kc")
+	if v := Evaluate(cards); v != newHandValue(StraightFlush, uint32(Five)<<16) {
+		t.Error("It should be Five-high StraightFlush")
+	}
+
+	cards = mustParseCards("9h 9s 9d 4c 4h 4s Ac")
+	if v := Evaluate(cards); v != newHandValue(FullHouse, uint32(Nine)<<16|uint32(Four)<<12) {
+		t.Error("It should be Nines full of Fours")
+	}
+}
+
+// 5장으로 만들 수 있는 모든 핸드(2,598,960개)의 족보 분포가 알려진 값과 같아야함
+func TestEvaluateAllFiveCardHands(t *testing.T) {
+	expected := map[HandsRank]int{
+		HighCard:           1302540,
+		OnePair:            1098240,
+		TwoPair:            123552,
+		Triple:             54912,
+		Straight:           10200,
+		Flush:              5108,
+		FullHouse:          3744,
+		FourCard:           624,
+		StraightFlush:      36,
+		RoyalStraightFlush: 4,
+	}
+
+	deck := newOrderedDeck()
+	counts := make(map[HandsRank]int)
+	hand := make([]Card, 5)
+
+	for a := 0; a < 48; a++ {
+		for b := a + 1; b < 49; b++ {
+			for c := b + 1; c < 50; c++ {
+				for d := c + 1; d < 51; d++ {
+					for e := d + 1; e < 52; e++ {
+						hand[0], hand[1], hand[2], hand[3], hand[4] = deck[a], deck[b], deck[c], deck[d], deck[e]
+						counts[Evaluate(hand).Category()]++
+					}
+				}
+			}
+		}
+	}
+
+	for rank, cnt := range expected {
+		if counts[rank] != cnt {
+			t.Errorf("HandsRank %d: expected %d but got %d", rank, cnt, counts[rank])
+		}
+	}
+}
+
+// 기존 조합 방식과 족보, 하이카드가 같은지 랜덤 샘플로 비교
+func TestEvaluateMatchesGetBestHandsRank(t *testing.T) {
+	n := 50000
+	if testing.Short() {
+		n = 5000
+	}
+
+	for _, hand := range randomHands(n, 7, 1) {
+		v := Evaluate(hand)
+
+		copied := make([]Card, len(hand))
+		copy(copied, hand)
+		_, handsRank, highCard := getBestHandsRank(copied)
+
+		if v.Category() != handsRank || Rank(v>>16&0xf) != highCard {
+			t.Fatalf("%v: Evaluate returned (%d, %d) but getBestHandsRank returned (%d, %d)",
+				hand, v.Category(), v>>16&0xf, handsRank, highCard)
+		}
+	}
+}
+
+// go test ./card -run AllSevenCardHands -exhaustive
+func TestEvaluateAllSevenCardHands(t *testing.T) {
+	if !*exhaustive {
+		t.Skip("run with -exhaustive to evaluate all seven-card hands")
+	}
+
+	expected := map[HandsRank]int{
+		HighCard:           23294460,
+		OnePair:            58627800,
+		TwoPair:            31433400,
+		Triple:             6461620,
+		Straight:           6180020,
+		Flush:              4047644,
+		FullHouse:          3473184,
+		FourCard:           224848,
+		StraightFlush:      37260,
+		RoyalStraightFlush: 4324,
+	}
+
+	deck := newOrderedDeck()
+	var counts [RoyalStraightFlush + 1]int
+	hand := make([]Card, 7)
+
+	for a := 0; a < 46; a++ {
+		hand[0] = deck[a]
+		for b := a + 1; b < 47; b++ {
+			hand[1] = deck[b]
+			for c := b + 1; c < 48; c++ {
+				hand[2] = deck[c]
+				for d := c + 1; d < 49; d++ {
+					hand[3] = deck[d]
+					for e := d + 1; e < 50; e++ {
+						hand[4] = deck[e]
+						for f := e + 1; f < 51; f++ {
+							hand[5] = deck[f]
+							for g := f + 1; g < 52; g++ {
+								hand[6] = deck[g]
+								counts[Evaluate(hand).Category()]++
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for rank, cnt := range expected {
+		if counts[rank] != cnt {
+			t.Errorf("HandsRank %d: expected %d but got %d", rank, cnt, counts[rank])
+		}
+	}
+}
+
+func BenchmarkEvaluate(b *testing.B) {
+	hands := randomHands(1024, 7, 2)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Evaluate(hands[i%len(hands)])
+	}
+}
+
+func BenchmarkGetBestHandsRank(b *testing.B) {
+	hands := randomHands(1024, 7, 2)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		getBestHandsRank(hands[i%len(hands)])
+	}
+}
@@ -0,0 +1,114 @@
+package card
+
+import (
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+)
+
+// 공정한 셔플 (commit-reveal)
+// 1. 핸드 시작 전 서버는 비밀 ServerSeed를 만들고 그 해시(CommitSeed)만 공개함
+// 2. 플레이어들은 각자 ClientSeed를 보냄
+// 3. 덱은 ServerSeed와 ClientSeed들로 FairShuffler를 이용해 결정적으로 섞임
+// 4. 핸드가 끝나면 ServerSeed를 공개하고 누구나 VerifyDeck으로 덱 순서를 다시 만들어 확인할 수 있음
+//
+// 서버는 ClientSeed를 미리 알 수 없고 플레이어는 ServerSeed를 미리 알 수 없으므로
+// 어느 한 쪽도 덱 순서를 조작할 수 없음
+
+// NewServerSeed는 32바이트 난수를 hex 문자열로 리턴함
+func NewServerSeed() string {
+	b := make([]byte, 32)
+	if _, err := crand.Read(b); err != nil {
+		panic("crypto/rand is unavailable: " + err.Error())
+	}
+	return hex.EncodeToString(b)
+}
+
+// CommitSeed는 ServerSeed의 sha256 해시를 hex 문자열로 리턴함
+func CommitSeed(serverSeed string) string {
+	sum := sha256.Sum256([]byte(serverSeed))
+	return hex.EncodeToString(sum[:])
+}
+
+// FairShuffler는 HMAC-SHA256(ServerSeed, ClientSeed들 + 카운터)로 만든 난수로 Fisher-Yates 셔플을 함
+// ClientSeed들은 쉼표로 이어붙이므로 각 ClientSeed에는 쉼표가 없어야함
+type FairShuffler struct {
+	serverSeed  string
+	clientSeeds []string
+}
+
+func NewFairShuffler(serverSeed string, clientSeeds []string) *FairShuffler {
+	return &FairShuffler{
+		serverSeed:  serverSeed,
+		clientSeeds: clientSeeds,
+	}
+}
+
+func (s *FairShuffler) Shuffle(cards []Card) {
+	stream := &seedStream{
+		key:     []byte(s.serverSeed),
+		message: strings.Join(s.clientSeeds, ","),
+	}
+
+	for i := len(cards) - 1; i > 0; i-- {
+		j := stream.intn(uint64(i + 1))
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+}
+
+type seedStream struct {
+	key     []byte
+	message string
+	counter uint64
+	buf     []byte
+}
+
+func (s *seedStream) next() uint64 {
+	if len(s.buf) < 8 {
+		mac := hmac.New(sha256.New, s.key)
+		mac.Write([]byte(s.message + ":" + strconv.FormatUint(s.counter, 10)))
+		s.buf = mac.Sum(nil)
+		s.counter++
+	}
+
+	v := binary.BigEndian.Uint64(s.buf[:8])
+	s.buf = s.buf[8:]
+	return v
+}
+
+// 0 이상 n 미만의 수를 편향 없이 리턴 (나머지 연산으로 생기는 편향을 막기 위해 범위를 넘는 값은 버림)
+func (s *seedStream) intn(n uint64) uint64 {
+	limit := ^uint64(0) - ^uint64(0)%n
+	for {
+		if v := s.next(); v < limit {
+			return v % n
+		}
+	}
+}
+
+// DeriveDeck은 ServerSeed와 ClientSeed들로 섞인 덱을 다시 만듬
+// opts로 덱 종류를 지정할 수 있고 Shuffler는 항상 FairShuffler가 사용됨
+func DeriveDeck(serverSeed string, clientSeeds []string, opts ...DeckOption) *Deck {
+	opts = append(opts, WithShuffler(NewFairShuffler(serverSeed, clientSeeds)))
+	return NewDeck(opts...)
+}
+
+// VerifyDeck은 공개된 ServerSeed가 핸드 시작 전의 commitment와 일치하는지,
+// 그리고 ServerSeed와 ClientSeed들로 만든 덱이 실제 덱과 같은지 확인함
+func VerifyDeck(serverSeed, commitment string, clientSeeds []string, deck []Card, opts ...DeckOption) error {
+	if CommitSeed(serverSeed) != strings.ToLower(commitment) {
+		return carderror.CommitmentMismatch
+	}
+
+	if !reflect.DeepEqual([]Card(*DeriveDeck(serverSeed, clientSeeds, opts...)), deck) {
+		return carderror.DeckMismatch
+	}
+	return nil
+}
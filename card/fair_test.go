@@ -0,0 +1,62 @@
+package card
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+)
+
+func TestFairShuffler(t *testing.T) {
+	serverSeed := NewServerSeed()
+	if len(serverSeed) != 64 {
+		t.Error("server seed should be 32 bytes hex string")
+	}
+
+	clientSeeds := []string{"alice-seed", "bob-seed"}
+	d1 := DeriveDeck(serverSeed, clientSeeds)
+	d2 := DeriveDeck(serverSeed, clientSeeds)
+	if !reflect.DeepEqual(d1, d2) {
+		t.Error("same seeds should derive same deck")
+	}
+	if len(*d1) != 52 {
+		t.Error("derived deck should have 52 cards")
+	}
+
+	if reflect.DeepEqual(d1, DeriveDeck(serverSeed, []string{"alice-seed", "mallory-seed"})) {
+		t.Error("different client seed should derive different deck")
+	}
+	if reflect.DeepEqual(d1, DeriveDeck(NewServerSeed(), clientSeeds)) {
+		t.Error("different server seed should derive different deck")
+	}
+}
+
+// 구현이 바뀌어서 과거 핸드를 검증할 수 없게 되는 것을 막기 위해 고정된 결과와 비교
+func TestFairShufflerIsStable(t *testing.T) {
+	d := DeriveDeck("server", []string{"client"})
+	if got := FormatCards((*d)[:5]); got != "9c Qc Js 8d 2c" {
+		t.Errorf("fair shuffle result changed: %s", got)
+	}
+}
+
+func TestVerifyDeck(t *testing.T) {
+	serverSeed := NewServerSeed()
+	commitment := CommitSeed(serverSeed)
+	clientSeeds := []string{"alice-seed"}
+	deck := []Card(*DeriveDeck(serverSeed, clientSeeds))
+
+	if err := VerifyDeck(serverSeed, commitment, clientSeeds, deck); err != nil {
+		t.Error(err.Error())
+	}
+
+	if err := VerifyDeck(NewServerSeed(), commitment, clientSeeds, deck); err != carderror.CommitmentMismatch {
+		t.Error("other server seed should return CommitmentMismatch")
+	}
+
+	swapped := make([]Card, len(deck))
+	copy(swapped, deck)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	if err := VerifyDeck(serverSeed, commitment, clientSeeds, swapped); err != carderror.DeckMismatch {
+		t.Error("rigged deck should return DeckMismatch")
+	}
+}
@@ -0,0 +1,96 @@
+package card
+
+// HandValue는 족보와 키커까지 모두 담은 핸드의 세기를 하나의 정수로 표현함
+// 값이 클수록 강한 핸드이므로 정수 비교만으로 승패를 가릴 수 있음
+//
+// 비트 구성 (상위 -> 하위)
+// 24~27: 족보의 순위 (숏덱처럼 족보 순서가 다른 경우에만 사용, 일반 덱은 0)
+// 20~23: 족보 (HandsRank)
+// 16~19: 첫번째로 비교할 랭크 (예: 풀하우스의 트리플, 투페어의 높은 페어)
+// 0~15 : 이후 비교할 랭크들을 4비트씩 순서대로 저장
+type HandValue uint32
+
+const (
+	categoryShift = 20
+	ordinalShift  = 24
+	rankBits      = 4
+)
+
+func newHandValue(category HandsRank, ranks uint32) HandValue {
+	return HandValue(uint32(category)<<categoryShift | ranks)
+}
+
+// Category는 족보를 리턴함
+func (v HandValue) Category() HandsRank {
+	return HandsRank(v >> categoryShift & 0xf)
+}
+
+// withOrdinal은 족보 순위를 최상위에 넣어서 정수 비교 결과가 해당 순위를 따르게 함
+func (v HandValue) withOrdinal(ordinal uint32) HandValue {
+	return HandValue(uint32(v)&^(0xf<<ordinalShift) | ordinal<<ordinalShift)
+}
+
+// Primary는 가장 먼저 비교하는 랭크를 리턴함
+// 예를 들어 포카드/트리플/페어의 랭크, 스트레이트/플러시/하이카드의 가장 높은 카드
+func (v HandValue) Primary() Rank {
+	return Rank(v >> 16 & 0xf)
+}
+
+// Secondary는 두번째로 비교하는 랭크를 리턴함
+// 풀하우스의 페어, 투페어의 낮은 페어만 해당되고 나머지 족보는 None을 리턴
+func (v HandValue) Secondary() Rank {
+	if !v.hasSecondary() {
+		return None
+	}
+	return Rank(v >> 12 & 0xf)
+}
+
+// Kickers는 족보를 이루는 카드들 이후에 비교하는 랭크들을 높은 순으로 리턴함
+func (v HandValue) Kickers() []Rank {
+	shift := 12
+	if v.hasSecondary() {
+		shift = 8
+	}
+
+	var kickers []Rank
+	for ; shift >= 0; shift -= rankBits {
+		if r := Rank(v >> uint(shift) & 0xf); r != 0 {
+			kickers = append(kickers, r)
+		}
+	}
+	return kickers
+}
+
+func (v HandValue) hasSecondary() bool {
+	category := v.Category()
+	return category == TwoPair || category == FullHouse
+}
+
+// Compare는 v가 other보다 강하면 1, 약하면 -1, 같으면 0을 리턴함
+func (v HandValue) Compare(other HandValue) int {
+	if v > other {
+		return 1
+	}
+	if v < other {
+		return -1
+	}
+	return 0
+}
+
+// 예: TwoPair(A, K; 9)
+func (v HandValue) String() string {
+	s := v.Category().String() + "(" + v.Primary().String()
+	if v.hasSecondary() {
+		s += ", " + v.Secondary().String()
+	}
+
+	for i, r := range v.Kickers() {
+		if i == 0 {
+			s += "; "
+		} else {
+			s += " "
+		}
+		s += r.String()
+	}
+	return s + ")"
+}
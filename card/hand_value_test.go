@@ -0,0 +1,159 @@
+package card
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHandValueFields(t *testing.T) {
+	v := Evaluate(mustParseCards("As Ah Ks Kh 9s 3h 2c"))
+	if v.Category() != TwoPair {
+		t.Error("Category should be TwoPair")
+	}
+	if v.Primary() != Ace || v.Secondary() != King {
+		t.Error("Primary should be Ace and Secondary should be King")
+	}
+	if !reflect.DeepEqual(v.Kickers(), []Rank{Nine}) {
+		t.Error("Kickers should be [Nine]")
+	}
+	if v.String() != "TwoPair(A, K; 9)" {
+		t.Errorf("unexpected String(): %s", v.String())
+	}
+
+	v = Evaluate(mustParseCards("7s 7h Qs Jh 4s 3d 2c"))
+	if v.Category() != OnePair || v.Primary() != Seven || v.Secondary() != None {
+		t.Error("It should be OnePair of Sevens without Secondary")
+	}
+	if !reflect.DeepEqual(v.Kickers(), []Rank{Queen, Jack, Four}) {
+		t.Error("Kickers should be [Queen, Jack, Four]")
+	}
+
+	v = Evaluate(mustParseCards("5s 4h 3s 2h Ad"))
+	if v.Category() != Straight || v.Primary() != Five || v.Kickers() != nil {
+		t.Error("It should be Five-high Straight without kickers")
+	}
+	if v.String() != "Straight(5)" {
+		t.Errorf("unexpected String(): %s", v.String())
+	}
+
+	v = Evaluate(mustParseCards("Ks Kh Kd 3h 3d"))
+	if v.String() != "FullHouse(K, 3)" {
+		t.Errorf("unexpected String(): %s", v.String())
+	}
+}
+
+func TestHandValueCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		hand1    []Card
+		hand2    []Card
+		expected int
+	}{
+		{
+			name:     "higher category wins",
+			hand1:    mustParseCards("2s 2h 3s 3h 4s"),
+			hand2:    mustParseCards("As Ah Ks Qh Js"),
+			expected: 1,
+		},
+		{
+			name:     "high card compares fifth kicker",
+			hand1:    mustParseCards("As Kh 9s 7h 4d"),
+			hand2:    mustParseCards("Ad Kc 9c 7d 3c"),
+			expected: 1,
+		},
+		{
+			name:     "one pair compares pair before kickers",
+			hand1:    mustParseCards("5s 5h As Kh Qd"),
+			hand2:    mustParseCards("6s 6h 4d 3h 2d"),
+			expected: -1,
+		},
+		{
+			name:     "one pair compares third kicker",
+			hand1:    mustParseCards("5s 5h As Kh 3d"),
+			hand2:    mustParseCards("5d 5c Ad Kc 4c"),
+			expected: -1,
+		},
+		{
+			name:     "two pair compares second pair before kicker",
+			hand1:    mustParseCards("As Ah 3s 3h Kd"),
+			hand2:    mustParseCards("Ad Ac 4d 4c 2d"),
+			expected: -1,
+		},
+		{
+			name:     "two pair compares kicker",
+			hand1:    mustParseCards("As Ah 3s 3h Kd"),
+			hand2:    mustParseCards("Ad Ac 3d 3c Qd"),
+			expected: 1,
+		},
+		{
+			name:     "triple compares kickers",
+			hand1:    mustParseCards("7s 7h 7d Ah 2d"),
+			hand2:    mustParseCards("7s 7h 7c Kh Qd"),
+			expected: 1,
+		},
+		{
+			name:     "wheel loses to six-high straight",
+			hand1:    mustParseCards("As 2h 3s 4h 5d"),
+			hand2:    mustParseCards("6s 2h 3s 4h 5d"),
+			expected: -1,
+		},
+		{
+			name:     "wheels split",
+			hand1:    mustParseCards("As 2h 3s 4h 5d"),
+			hand2:    mustParseCards("Ah 2d 3c 4c 5c"),
+			expected: 0,
+		},
+		{
+			name:     "flush compares every card",
+			hand1:    mustParseCards("As Ks 9s 7s 3s"),
+			hand2:    mustParseCards("Ah Kh 9h 7h 4h"),
+			expected: -1,
+		},
+		{
+			name:     "full house compares triple before pair",
+			hand1:    mustParseCards("3s 3h 3d Ah Ad"),
+			hand2:    mustParseCards("4s 4h 4d 2h 2d"),
+			expected: -1,
+		},
+		{
+			name:     "full house compares pair",
+			hand1:    mustParseCards("3s 3h 3d Qh Qd"),
+			hand2:    mustParseCards("3s 3h 3c 2h 2d"),
+			expected: 1,
+		},
+		{
+			name:     "four card compares kicker",
+			hand1:    mustParseCards("9s 9h 9d 9c 2d"),
+			hand2:    mustParseCards("9s 9h 9d 9c 3d"),
+			expected: -1,
+		},
+		{
+			name:     "steel wheel loses to six-high straight flush",
+			hand1:    mustParseCards("As 2s 3s 4s 5s"),
+			hand2:    mustParseCards("6h 2h 3h 4h 5h"),
+			expected: -1,
+		},
+		{
+			name:     "identical board plays for both players",
+			hand1:    mustParseCards("2s 3h Ts Th Kd Kc As"),
+			hand2:    mustParseCards("4d 5c Ts Th Kd Kc As"),
+			expected: 0,
+		},
+		{
+			name:     "only the best five cards count",
+			hand1:    mustParseCards("Qs 2h 9s 9h Ad Ac Ks"),
+			hand2:    mustParseCards("Qd 3c 9s 9h Ad Ac Ks"),
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		v1, v2 := Evaluate(tt.hand1), Evaluate(tt.hand2)
+		if res := v1.Compare(v2); res != tt.expected {
+			t.Errorf("%s: %s vs %s should be %d but got %d", tt.name, v1, v2, tt.expected, res)
+		}
+		if res := v2.Compare(v1); res != -tt.expected {
+			t.Errorf("%s: reversed comparison should be %d but got %d", tt.name, -tt.expected, res)
+		}
+	}
+}
@@ -15,6 +15,26 @@ const (
 	RoyalStraightFlush
 )
 
+var handsRankNames = map[HandsRank]string{
+	HighCard:           "HighCard",
+	OnePair:            "OnePair",
+	TwoPair:            "TwoPair",
+	Triple:             "Triple",
+	Straight:           "Straight",
+	Flush:              "Flush",
+	FullHouse:          "FullHouse",
+	FourCard:           "FourCard",
+	StraightFlush:      "StraightFlush",
+	RoyalStraightFlush: "RoyalStraightFlush",
+}
+
+func (h HandsRank) String() string {
+	if name, ok := handsRankNames[h]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
 // 7장의 카드로부터 만들 수 있는 조합 중
 // 가장 좋은 5장의 조합을 리턴함
 func getBestHandsRank(cards []Card) ([]Card, HandsRank, Rank) {
@@ -307,6 +327,12 @@ func isTwoPair(cards []Card) (bool, Rank) {
 		return true, cards[4].Rank
 	}
 
+	// 1, 2번째와 4, 5번째가 페어이고 가운데가 키커인 경우
+	if (cards[0].Rank == cards[1].Rank) &&
+		(cards[3].Rank == cards[4].Rank) {
+		return true, cards[4].Rank
+	}
+
 	return false, None
 }
 
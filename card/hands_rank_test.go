@@ -10,15 +10,7 @@ var res bool
 var highCard Rank
 
 func TestMakeAllCombinations(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Queen},
-		{Symbol: Heart, Rank: Six},
-		{Symbol: Diamond, Rank: Ace},
-		{Symbol: Spade, Rank: King},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Clover, Rank: Three},
-		{Symbol: Clover, Rank: Two},
-	}
+	cards = mustParseCards("Qs 6h Ad Ks 6s 3c 2c")
 
 	var allCombs [][]Card
 
@@ -35,24 +27,10 @@ func TestGetBestHandsRank(t *testing.T) {
 	var bestHighCard Rank
 	var expected []Card
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Queen},
-		{Symbol: Heart, Rank: Six},
-		{Symbol: Diamond, Rank: Ace},
-		{Symbol: Spade, Rank: King},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Clover, Rank: Three},
-		{Symbol: Clover, Rank: Two},
-	}
+	cards = mustParseCards("Qs 6h Ad Ks 6s 3c 2c")
 	bestCards, bestHandsRank, bestHighCard = getBestHandsRank(cards)
 
-	expected = []Card{
-		{Symbol: Heart, Rank: Six},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Spade, Rank: Queen},
-		{Symbol: Spade, Rank: King},
-		{Symbol: Diamond, Rank: Ace},
-	}
+	expected = mustParseCards("6h 6s Qs Ks Ad")
 	if !reflect.DeepEqual(bestCards, expected) {
 		t.Error("BestCard is wrong")
 	}
@@ -63,24 +41,10 @@ func TestGetBestHandsRank(t *testing.T) {
 		t.Error("HighCard should be Six")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Queen},
-		{Symbol: Heart, Rank: Six},
-		{Symbol: Diamond, Rank: Ace},
-		{Symbol: Spade, Rank: King},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Two},
-	}
+	cards = mustParseCards("Qs 6h Ad Ks 6s 3s 2s")
 	bestCards, bestHandsRank, bestHighCard = getBestHandsRank(cards)
 
-	expected = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Spade, Rank: Queen},
-		{Symbol: Spade, Rank: King},
-	}
+	expected = mustParseCards("2s 3s 6s Qs Ks")
 	if !reflect.DeepEqual(bestCards, expected) {
 		t.Error("BestCard is wrong")
 	}
@@ -91,24 +55,10 @@ func TestGetBestHandsRank(t *testing.T) {
 		t.Error("HighCard should be King")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Six},
-		{Symbol: Diamond, Rank: Six},
-		{Symbol: Spade, Rank: King},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Clover, Rank: Two},
-	}
+	cards = mustParseCards("2s 6h 6d Ks 6s 3s 2c")
 	bestCards, bestHandsRank, bestHighCard = getBestHandsRank(cards)
 
-	expected = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Clover, Rank: Two},
-		{Symbol: Heart, Rank: Six},
-		{Symbol: Diamond, Rank: Six},
-		{Symbol: Spade, Rank: Six},
-	}
+	expected = mustParseCards("2s 2c 6h 6d 6s")
 	if !reflect.DeepEqual(bestCards, expected) {
 		t.Error("BestCard is wrong")
 	}
@@ -121,13 +71,7 @@ func TestGetBestHandsRank(t *testing.T) {
 }
 
 func TestRoyalStraightFlush(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Ten},
-		{Symbol: Spade, Rank: Jack},
-		{Symbol: Spade, Rank: Queen},
-		{Symbol: Spade, Rank: King},
-		{Symbol: Spade, Rank: Ace},
-	}
+	cards = mustParseCards("Ts Js Qs Ks As")
 	res, highCard = isRoyalStraightFlush(cards)
 	if !res {
 		t.Error("It should be RoyalStraightFlush but wrong result")
@@ -136,13 +80,7 @@ func TestRoyalStraightFlush(t *testing.T) {
 		t.Error("RoyalStraightFlush must be end with Ace")
 	}
 
-	cards2 := []Card{
-		{Symbol: Spade, Rank: Nine},
-		{Symbol: Spade, Rank: Ten},
-		{Symbol: Spade, Rank: Jack},
-		{Symbol: Spade, Rank: Queen},
-		{Symbol: Spade, Rank: King},
-	}
+	cards2 := mustParseCards("9s Ts Js Qs Ks")
 	res, highCard = isRoyalStraightFlush(cards2)
 	if res {
 		t.Error("RoyalStraightFlush's highCard should be Ace")
@@ -150,13 +88,7 @@ func TestRoyalStraightFlush(t *testing.T) {
 }
 
 func TestStraightFlush(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Spade, Rank: Five},
-		{Symbol: Spade, Rank: Ace},
-	}
+	cards = mustParseCards("2s 3s 4s 5s As")
 	res, highCard = isStraightFlush(cards)
 	if !res {
 		t.Error("It should be StraightFlush")
@@ -165,13 +97,7 @@ func TestStraightFlush(t *testing.T) {
 		t.Error("highCard should be Five")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Spade, Rank: Five},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Spade, Rank: Seven},
-		{Symbol: Spade, Rank: Eight},
-	}
+	cards = mustParseCards("4s 5s 6s 7s 8s")
 	res, highCard = isStraightFlush(cards)
 	if !res {
 		t.Error("It should be StraightFlush")
@@ -180,25 +106,13 @@ func TestStraightFlush(t *testing.T) {
 		t.Error("highCard should be Eight")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Spade, Rank: Five},
-		{Symbol: Spade, Rank: Seven},
-		{Symbol: Spade, Rank: Eight},
-		{Symbol: Spade, Rank: Ten},
-	}
+	cards = mustParseCards("4s 5s 7s 8s Ts")
 	res, _ = isStraightFlush(cards)
 	if res {
 		t.Error("It should not be StraightFlush")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Seven},
-		{Symbol: Spade, Rank: Eight},
-		{Symbol: Spade, Rank: Ten},
-	}
+	cards = mustParseCards("4s 5h 7s 8s Ts")
 	res, _ = isStraightFlush(cards)
 	if res {
 		t.Error("It should not be StraightFlush")
@@ -206,13 +120,7 @@ func TestStraightFlush(t *testing.T) {
 }
 
 func TestFourCard(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Two},
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Spade, Rank: Ten},
-	}
+	cards = mustParseCards("2s 2h 2s 2s Ts")
 	res, highCard = isFourCard(cards)
 	if !res {
 		t.Error("It should be FourCard")
@@ -221,13 +129,7 @@ func TestFourCard(t *testing.T) {
 		t.Error("highCard should be Two")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-	}
+	cards = mustParseCards("2s 3h 3s 3s 3s")
 	res, highCard = isFourCard(cards)
 	if !res {
 		t.Error("It should be FourCard")
@@ -236,13 +138,7 @@ func TestFourCard(t *testing.T) {
 		t.Error("highCard should be Three")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Spade, Rank: Ten},
-	}
+	cards = mustParseCards("2s 3h 4s 6s Ts")
 	res, _ = isFourCard(cards)
 	if res {
 		t.Error("It should not be FourCard")
@@ -250,13 +146,7 @@ func TestFourCard(t *testing.T) {
 }
 
 func TestFullHouse(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Two},
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-	}
+	cards = mustParseCards("2s 2h 2s 3s 3s")
 	res, highCard = isFullHouse(cards)
 	if !res {
 		t.Error("It should be FullHouse")
@@ -265,13 +155,7 @@ func TestFullHouse(t *testing.T) {
 		t.Error("highChard should be Two")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Two},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-	}
+	cards = mustParseCards("2s 2h 3s 3s 3s")
 	res, highCard = isFullHouse(cards)
 	if !res {
 		t.Error("It should be FullHouse")
@@ -280,13 +164,7 @@ func TestFullHouse(t *testing.T) {
 		t.Error("highCard should be Three")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Spade, Rank: Five},
-	}
+	cards = mustParseCards("2s 3h 3s 4s 5s")
 	res, _ = isFullHouse(cards)
 	if res {
 		t.Error("It should not be FullHouse")
@@ -294,13 +172,7 @@ func TestFullHouse(t *testing.T) {
 }
 
 func TestFlush(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-	}
+	cards = mustParseCards("2s 2s 2s 3s 3s")
 	res, highCard = isFlush(cards)
 	if !res {
 		t.Error("It should be Flush")
@@ -309,13 +181,7 @@ func TestFlush(t *testing.T) {
 		t.Error("highCard should be Three")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Two},
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-	}
+	cards = mustParseCards("2s 2h 2s 3s 3s")
 	res, _ = isFlush(cards)
 	if res {
 		t.Error("It should not be Flush")
@@ -323,13 +189,7 @@ func TestFlush(t *testing.T) {
 }
 
 func TestStraight(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Six},
-	}
+	cards = mustParseCards("2s 3h 4s 5h 6s")
 	res, highCard = isStraight(cards)
 	if !res {
 		t.Error("It should be Straight")
@@ -338,13 +198,7 @@ func TestStraight(t *testing.T) {
 		t.Error("highCard should be Six")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Spade, Rank: Five},
-		{Symbol: Heart, Rank: Ace},
-	}
+	cards = mustParseCards("2s 3h 4s 5s Ah")
 	res, highCard = isStraight(cards)
 	if !res {
 		t.Error("It should be Straight")
@@ -353,13 +207,7 @@ func TestStraight(t *testing.T) {
 		t.Error("highCard should be Five")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Spade, Rank: King},
-		{Symbol: Heart, Rank: Ace},
-	}
+	cards = mustParseCards("2s 3h 4s Ks Ah")
 	res, _ = isStraight(cards)
 	if res {
 		t.Error("It should not be Straight")
@@ -367,13 +215,7 @@ func TestStraight(t *testing.T) {
 }
 
 func TestTriple(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Two},
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Six},
-	}
+	cards = mustParseCards("2s 2h 2s 5h 6s")
 	res, highCard = isTriple(cards)
 	if !res {
 		t.Error("It should be Triple")
@@ -382,13 +224,7 @@ func TestTriple(t *testing.T) {
 		t.Error("highCard should be Two")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Five},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Six},
-	}
+	cards = mustParseCards("2s 5h 5s 5h 6s")
 	res, highCard = isTriple(cards)
 	if !res {
 		t.Error("It should be Triple")
@@ -397,13 +233,7 @@ func TestTriple(t *testing.T) {
 		t.Error("highCard should be Five")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Heart, Rank: Six},
-		{Symbol: Spade, Rank: Six},
-	}
+	cards = mustParseCards("2s 3h 6s 6h 6s")
 	res, highCard = isTriple(cards)
 	if !res {
 		t.Error("It should be Triple")
@@ -412,13 +242,7 @@ func TestTriple(t *testing.T) {
 		t.Error("highCard should be Six")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Heart, Rank: Ten},
-		{Symbol: Spade, Rank: Jack},
-	}
+	cards = mustParseCards("2s 3h 6s Th Js")
 	res, _ = isTriple(cards)
 	if res {
 		t.Error("It should not be Triple")
@@ -426,13 +250,7 @@ func TestTriple(t *testing.T) {
 }
 
 func TestTwoPair(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Two},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Six},
-	}
+	cards = mustParseCards("2s 2h 3s 3h 6s")
 	res, highCard = isTwoPair(cards)
 	if !res {
 		t.Error("It should be TwoPair")
@@ -441,13 +259,7 @@ func TestTwoPair(t *testing.T) {
 		t.Error("highCard should be Three")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Heart, Rank: Six},
-		{Symbol: Spade, Rank: Six},
-	}
+	cards = mustParseCards("2s 3h 3s 6h 6s")
 	res, highCard = isTwoPair(cards)
 	if !res {
 		t.Error("It should be TwoPair")
@@ -456,13 +268,16 @@ func TestTwoPair(t *testing.T) {
 		t.Error("highCard should be Six")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Six},
+	cards = mustParseCards("2s 2h 9s Ah As")
+	res, highCard = isTwoPair(cards)
+	if !res {
+		t.Error("It should be TwoPair")
 	}
+	if highCard != Ace {
+		t.Error("highCard should be Ace")
+	}
+
+	cards = mustParseCards("2s 3h 3s 5h 6s")
 	res, _ = isTwoPair(cards)
 	if res {
 		t.Error("It should not be TwoPair")
@@ -470,13 +285,7 @@ func TestTwoPair(t *testing.T) {
 }
 
 func TestOnePair(t *testing.T) {
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Two},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Six},
-	}
+	cards = mustParseCards("2s 2h 3s 5h 6s")
 	res, highCard = isOnePair(cards)
 	if !res {
 		t.Error("It should be OnePair")
@@ -485,13 +294,7 @@ func TestOnePair(t *testing.T) {
 		t.Error("highCard should be Two")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Three},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Six},
-	}
+	cards = mustParseCards("2s 3h 3s 5h 6s")
 	res, highCard = isOnePair(cards)
 	if !res {
 		t.Error("It should be OnePair")
@@ -500,13 +303,7 @@ func TestOnePair(t *testing.T) {
 		t.Error("highCard should be Three")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Five},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Six},
-	}
+	cards = mustParseCards("2s 3h 5s 5h 6s")
 	res, highCard = isOnePair(cards)
 	if !res {
 		t.Error("It should be OnePair")
@@ -515,13 +312,7 @@ func TestOnePair(t *testing.T) {
 		t.Error("highCard should be Five")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Three},
-		{Symbol: Spade, Rank: Four},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Five},
-	}
+	cards = mustParseCards("2s 3h 4s 5h 5s")
 	res, highCard = isOnePair(cards)
 	if !res {
 		t.Error("It should be OnePair")
@@ -530,13 +321,7 @@ func TestOnePair(t *testing.T) {
 		t.Error("highCard should be Five")
 	}
 
-	cards = []Card{
-		{Symbol: Spade, Rank: Two},
-		{Symbol: Heart, Rank: Five},
-		{Symbol: Spade, Rank: Six},
-		{Symbol: Heart, Rank: Jack},
-		{Symbol: Spade, Rank: King},
-	}
+	cards = mustParseCards("2s 5h 6s Jh Ks")
 	res, _ = isOnePair(cards)
 	if res {
 		t.Error("It should not be OnePair")
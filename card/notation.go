@@ -0,0 +1,184 @@
+package card
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+)
+
+// 두 글자 표기법
+// 첫 글자는 랭크 (2~9, T, J, Q, K, A), 두번째 글자는 무늬 (s, h, d, c)
+// 예: As(스페이드 A), Td(다이아몬드 10), 7c(클로버 7)
+
+var symbolLetters = map[Symbol]string{
+	Spade:   "s",
+	Heart:   "h",
+	Diamond: "d",
+	Clover:  "c",
+}
+
+var symbolUnicodes = map[Symbol]string{
+	Spade:   "♠",
+	Heart:   "♥",
+	Diamond: "♦",
+	Clover:  "♣",
+}
+
+var letterSymbols = map[rune]Symbol{
+	's': Spade, '♠': Spade, '♤': Spade,
+	'h': Heart, '♥': Heart, '♡': Heart,
+	'd': Diamond, '♦': Diamond, '♢': Diamond,
+	'c': Clover, '♣': Clover, '♧': Clover,
+}
+
+var letterRanks = map[rune]Rank{
+	'2': Two,
+	'3': Three,
+	'4': Four,
+	'5': Five,
+	'6': Six,
+	'7': Seven,
+	'8': Eight,
+	'9': Nine,
+	't': Ten,
+	'j': Jack,
+	'q': Queen,
+	'k': King,
+	'a': Ace,
+}
+
+// 예: As
+func (c Card) String() string {
+	return c.Rank.String() + symbolLetters[c.Symbol]
+}
+
+// Unicode는 무늬를 유니코드 기호로 표시함 (예: A♠)
+func (c Card) Unicode() string {
+	return c.Rank.String() + symbolUnicodes[c.Symbol]
+}
+
+// FormatCards는 카드들을 공백으로 구분된 두 글자 표기로 바꿈 (예: "As Kd 7c")
+func FormatCards(cards []Card) string {
+	notations := make([]string, len(cards))
+	for i, c := range cards {
+		notations[i] = c.String()
+	}
+	return strings.Join(notations, " ")
+}
+
+// ParseCard는 "As" 같은 두 글자 표기를 카드로 바꿈
+// 대소문자는 구분하지 않고 무늬는 유니코드 기호(♠♥♦♣)도 사용할 수 있음
+func ParseCard(s string) (Card, error) {
+	runes := []rune(strings.TrimSpace(s))
+	if len(runes) != 2 {
+		return Card{}, carderror.InvalidNotation
+	}
+	return parseRunes(runes[0], runes[1])
+}
+
+// ParseCards는 여러 장의 카드를 한 번에 바꿈
+// 카드 사이의 공백이나 쉼표는 있어도 되고 없어도 됨 (예: "AsKd 7c", "As, Kd, 7c")
+func ParseCards(s string) ([]Card, error) {
+	var runes []rune
+	for _, r := range s {
+		if unicode.IsSpace(r) || r == ',' {
+			continue
+		}
+		runes = append(runes, r)
+	}
+
+	if len(runes)%2 != 0 {
+		return nil, carderror.InvalidNotation
+	}
+
+	cards := make([]Card, 0, len(runes)/2)
+	for i := 0; i < len(runes); i += 2 {
+		c, err := parseRunes(runes[i], runes[i+1])
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+	}
+	return cards, nil
+}
+
+// ParseRank는 "A", "t", "9" 같은 한 글자 랭크 표기를 랭크로 바꿈
+func ParseRank(s string) (Rank, error) {
+	runes := []rune(strings.TrimSpace(s))
+	if len(runes) != 1 {
+		return None, carderror.InvalidNotation
+	}
+
+	rank, ok := letterRanks[unicode.ToLower(runes[0])]
+	if !ok {
+		return None, carderror.InvalidNotation
+	}
+	return rank, nil
+}
+
+func parseRunes(rankLetter, symbolLetter rune) (Card, error) {
+	rank, ok := letterRanks[unicode.ToLower(rankLetter)]
+	if !ok {
+		return Card{}, carderror.InvalidNotation
+	}
+
+	symbol, ok := letterSymbols[unicode.ToLower(symbolLetter)]
+	if !ok {
+		return Card{}, carderror.InvalidNotation
+	}
+
+	return Card{Symbol: symbol, Rank: rank}, nil
+}
+
+// CompactCard는 JSON으로 {"Symbol":"Spade","Rank":14} 대신 "As"처럼 두 글자로 직렬화됨
+// Card의 기본 JSON 형태는 redis 저장 등에서 그대로 쓰이므로 필요한 곳에서만 변환해서 사용
+type CompactCard Card
+
+func (c CompactCard) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Card(c).String())
+}
+
+func (c *CompactCard) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := ParseCard(s)
+	if err != nil {
+		return err
+	}
+	*c = CompactCard(parsed)
+	return nil
+}
+
+// CompactCards는 ["As","Kd"] 형태로 직렬화되는 카드 목록
+type CompactCards []Card
+
+func (cards CompactCards) MarshalJSON() ([]byte, error) {
+	notations := make([]string, len(cards))
+	for i, c := range cards {
+		notations[i] = c.String()
+	}
+	return json.Marshal(notations)
+}
+
+func (cards *CompactCards) UnmarshalJSON(data []byte) error {
+	var notations []string
+	if err := json.Unmarshal(data, &notations); err != nil {
+		return err
+	}
+
+	parsed := make(CompactCards, len(notations))
+	for i, s := range notations {
+		c, err := ParseCard(s)
+		if err != nil {
+			return err
+		}
+		parsed[i] = c
+	}
+	*cards = parsed
+	return nil
+}
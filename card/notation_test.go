@@ -0,0 +1,113 @@
+package card
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+)
+
+// 테스트 픽스처를 "As Kd 7c" 같은 문자열로 쓰기 위한 헬퍼
+func mustParseCards(s string) []Card {
+	cards, err := ParseCards(s)
+	if err != nil {
+		panic(err)
+	}
+	return cards
+}
+
+func TestParseCard(t *testing.T) {
+	c, err := ParseCard("As")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if c != (Card{Symbol: Spade, Rank: Ace}) {
+		t.Error("As should be Ace of Spades")
+	}
+
+	c, _ = ParseCard("tD")
+	if c != (Card{Symbol: Diamond, Rank: Ten}) {
+		t.Error("tD should be Ten of Diamonds")
+	}
+
+	c, _ = ParseCard("7♣")
+	if c != (Card{Symbol: Clover, Rank: Seven}) {
+		t.Error("7♣ should be Seven of Clovers")
+	}
+
+	for _, s := range []string{"", "A", "Asd", "1s", "Ax", "10s"} {
+		if _, err := ParseCard(s); err != carderror.InvalidNotation {
+			t.Errorf("%q should return InvalidNotation", s)
+		}
+	}
+}
+
+func TestParseCards(t *testing.T) {
+	expected := []Card{
+		{Symbol: Spade, Rank: Ace},
+		{Symbol: Diamond, Rank: King},
+		{Symbol: Clover, Rank: Seven},
+	}
+
+	for _, s := range []string{"AsKd 7c", "As Kd 7c", "As, Kd, 7c", "A♠K♦7♣"} {
+		cards, err := ParseCards(s)
+		if err != nil {
+			t.Errorf("%q: %s", s, err.Error())
+		}
+		if !reflect.DeepEqual(cards, expected) {
+			t.Errorf("%q parsed wrong: %v", s, cards)
+		}
+	}
+
+	if _, err := ParseCards("AsK"); err != carderror.InvalidNotation {
+		t.Error("odd number of letters should return InvalidNotation")
+	}
+}
+
+func TestFormatCards(t *testing.T) {
+	cards := mustParseCards("As Td 2h 9c")
+
+	if FormatCards(cards) != "As Td 2h 9c" {
+		t.Errorf("unexpected FormatCards: %s", FormatCards(cards))
+	}
+	if cards[1].Unicode() != "T♦" {
+		t.Errorf("unexpected Unicode: %s", cards[1].Unicode())
+	}
+}
+
+func TestCompactJSON(t *testing.T) {
+	cards := mustParseCards("As Kd")
+
+	data, err := json.Marshal(CompactCards(cards))
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if string(data) != `["As","Kd"]` {
+		t.Errorf("unexpected json: %s", data)
+	}
+
+	var decoded CompactCards
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Error(err.Error())
+	}
+	if !reflect.DeepEqual([]Card(decoded), cards) {
+		t.Error("decoded cards should be same as original")
+	}
+
+	data, _ = json.Marshal(CompactCard(cards[0]))
+	if string(data) != `"As"` {
+		t.Errorf("unexpected json: %s", data)
+	}
+
+	var c CompactCard
+	if err := json.Unmarshal([]byte(`"Zz"`), &c); err != carderror.InvalidNotation {
+		t.Error("invalid notation should return InvalidNotation")
+	}
+
+	// 기본 Card의 JSON 형태는 그대로 유지되어야함
+	data, _ = json.Marshal(cards[0])
+	if string(data) != `{"Symbol":"Spade","Rank":14}` {
+		t.Errorf("Card's default json should not change: %s", data)
+	}
+}
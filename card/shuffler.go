@@ -0,0 +1,51 @@
+package card
+
+import (
+	crand "crypto/rand"
+	"math/big"
+	"math/rand"
+)
+
+// Shuffler는 덱을 섞는 방법을 정의함
+// 실제 게임에서는 CryptoShuffler를, 테스트나 리플레이에서는 SeededShuffler를 사용
+type Shuffler interface {
+	Shuffle(cards []Card)
+}
+
+// CryptoShuffler는 crypto/rand를 이용해 예측할 수 없는 순서로 섞음
+type CryptoShuffler struct{}
+
+func NewCryptoShuffler() *CryptoShuffler {
+	return &CryptoShuffler{}
+}
+
+// Fisher-Yates 셔플
+func (s *CryptoShuffler) Shuffle(cards []Card) {
+	for i := len(cards) - 1; i > 0; i-- {
+		n, err := crand.Int(crand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			// 운영체제의 난수 생성기를 사용할 수 없는 경우로 예측 가능한 덱으로 게임을 진행하면 안됨
+			panic("crypto/rand is unavailable: " + err.Error())
+		}
+		j := int(n.Int64())
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+}
+
+// SeededShuffler는 같은 시드에 대해 항상 같은 순서로 섞음
+// 전역 math/rand 소스를 건드리지 않고 자신만의 소스를 사용함
+type SeededShuffler struct {
+	r *rand.Rand
+}
+
+func NewSeededShuffler(seed int64) *SeededShuffler {
+	return &SeededShuffler{
+		r: rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (s *SeededShuffler) Shuffle(cards []Card) {
+	s.r.Shuffle(len(cards), func(i, j int) {
+		cards[i], cards[j] = cards[j], cards[i]
+	})
+}
@@ -0,0 +1,79 @@
+package card
+
+// Variant는 덱 구성과 족보 순서가 다른 홀덤 종류
+type Variant int
+
+const (
+	Standard  Variant = iota // 52장 덱
+	ShortDeck                // 2~5를 뺀 36장 덱 (6+ 홀덤)
+)
+
+var variantNames = map[Variant]string{
+	Standard:  "Standard",
+	ShortDeck: "ShortDeck",
+}
+
+func (v Variant) String() string {
+	if name, ok := variantNames[v]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// 숏덱에서 가장 높은 스트레이트의 하이카드 테이블 (A, 6, 7, 8, 9가 가장 낮은 스트레이트)
+var shortDeckStraightHighTable [1 << 13]Rank
+
+// 숏덱은 플러시가 풀하우스보다 높음 (플러시를 만들 수 있는 카드가 더 적기 때문)
+var shortDeckOrdinals = map[HandsRank]uint32{
+	HighCard:           1,
+	OnePair:            2,
+	TwoPair:            3,
+	Triple:             4,
+	Straight:           5,
+	FullHouse:          6,
+	Flush:              7,
+	FourCard:           8,
+	StraightFlush:      9,
+	RoyalStraightFlush: 10,
+}
+
+func findShortDeckStraightHigh(mask uint16) Rank {
+	if high := findStraightHigh(mask); high != 0 && high != Five {
+		return high
+	}
+
+	wheel := rankBit(Ace) | rankBit(Six) | rankBit(Seven) | rankBit(Eight) | rankBit(Nine)
+	if mask&wheel == wheel {
+		return Nine
+	}
+	return 0
+}
+
+// 덱에 들어가는 가장 낮은 랭크
+func (v Variant) minRank() Rank {
+	if v == ShortDeck {
+		return Six
+	}
+	return Two
+}
+
+// DeckSize는 해당 종류의 덱에 들어있는 카드 수를 리턴함
+func (v Variant) DeckSize() int {
+	return int(Ace-v.minRank()+1) * 4
+}
+
+// Evaluate는 해당 종류의 족보 순서에 맞는 HandValue를 리턴함
+// 서로 다른 Variant의 HandValue끼리는 비교하면 안됨
+func (v Variant) Evaluate(cards []Card) HandValue {
+	if v != ShortDeck {
+		return Evaluate(cards)
+	}
+
+	value := evaluate(cards, &shortDeckStraightHighTable)
+	return value.withOrdinal(shortDeckOrdinals[value.Category()])
+}
+
+// GetBestHand는 해당 종류의 족보로 5~7장 중 가장 좋은 5장의 조합을 리턴함
+func (v Variant) GetBestHand(cards []Card) (BestHand, error) {
+	return getBestHand(cards, v.Evaluate)
+}
@@ -0,0 +1,118 @@
+package card
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShortDeck(t *testing.T) {
+	d := NewDeck(WithVariant(ShortDeck))
+	if len(*d) != 36 || ShortDeck.DeckSize() != 36 || Standard.DeckSize() != 52 {
+		t.Error("short deck should have 36 cards")
+	}
+	for _, c := range *d {
+		if c.Rank < Six {
+			t.Errorf("short deck should not have %s", c)
+		}
+	}
+}
+
+func TestShortDeckEvaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		hand1    string
+		hand2    string
+		expected int
+	}{
+		{"flush beats full house", "As Ks 9s 7s 6s", "Kh Kd Kc 6h 6d", 1},
+		{"A-6-7-8-9 is a straight", "As 6h 7s 8h 9d", "Ah Ad Ac Kh Qd", 1},
+		{"A-6-7-8-9 is the lowest straight", "As 6h 7s 8h 9d", "6s 7h 8s 9h Td", -1},
+		{"A-6-7-8-9 suited is a straight flush", "As 6s 7s 8s 9s", "Kh Kd Kc Ks 6d", 1},
+		{"full house still compares triple first", "Kh Kd Kc 6h 6d", "Qh Qd Qc Ah Ad", 1},
+	}
+
+	for _, tt := range tests {
+		v1, v2 := ShortDeck.Evaluate(mustParseCards(tt.hand1)), ShortDeck.Evaluate(mustParseCards(tt.hand2))
+		if res := v1.Compare(v2); res != tt.expected {
+			t.Errorf("%s: %s vs %s should be %d but got %d", tt.name, v1, v2, tt.expected, res)
+		}
+	}
+
+	v := ShortDeck.Evaluate(mustParseCards("As 6h 7s 8h 9d Kc"))
+	if v.Category() != Straight || v.Primary() != Nine {
+		t.Errorf("It should be Nine-high Straight but got %s", v)
+	}
+	if v.Describe(English) != "Straight, Nine-high" {
+		t.Errorf("unexpected description: %s", v.Describe(English))
+	}
+
+	// 일반 덱에서는 풀하우스가 플러시보다 높음
+	if Standard.Evaluate(mustParseCards("As Ks 9s 7s 6s")).Compare(Standard.Evaluate(mustParseCards("Kh Kd Kc 6h 6d"))) != -1 {
+		t.Error("full house should beat flush in standard deck")
+	}
+	if Standard.Evaluate(mustParseCards("As 6h 7s 8h 9d")).Category() != HighCard {
+		t.Error("A-6-7-8-9 should not be a straight in standard deck")
+	}
+}
+
+// 숏덱 5장으로 만들 수 있는 모든 핸드(376,992개)의 족보 분포
+func TestShortDeckAllFiveCardHands(t *testing.T) {
+	expected := map[HandsRank]int{
+		HighCard:           122400,
+		OnePair:            193536,
+		TwoPair:            36288,
+		Triple:             16128,
+		Straight:           6120,
+		Flush:              480,
+		FullHouse:          1728,
+		FourCard:           288,
+		StraightFlush:      20,
+		RoyalStraightFlush: 4,
+	}
+
+	var deck []Card
+	for _, c := range newOrderedDeck() {
+		if c.Rank >= Six {
+			deck = append(deck, c)
+		}
+	}
+
+	counts := make(map[HandsRank]int)
+	hand := make([]Card, 5)
+	n := len(deck)
+	for a := 0; a < n-4; a++ {
+		for b := a + 1; b < n-3; b++ {
+			for c := b + 1; c < n-2; c++ {
+				for d := c + 1; d < n-1; d++ {
+					for e := d + 1; e < n; e++ {
+						hand[0], hand[1], hand[2], hand[3], hand[4] = deck[a], deck[b], deck[c], deck[d], deck[e]
+						counts[ShortDeck.Evaluate(hand).Category()]++
+					}
+				}
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("expected %v but got %v", expected, counts)
+	}
+}
+
+func TestShortDeckGetBestHand(t *testing.T) {
+	bestHand, err := ShortDeck.GetBestHand(mustParseCards("Ks Kh Kd 6s 6h 9s As"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if bestHand.Value.Category() != FullHouse {
+		t.Errorf("expected FullHouse but got %s", bestHand.Value)
+	}
+
+	// A-6-7-8-9 스트레이트와 원페어보다 스페이드 플러시가 높음
+	bestHand, err = ShortDeck.GetBestHand(mustParseCards("Ks Kh 7s 6s 8h 9s As"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if bestHand.Value.Category() != Flush || FormatCards(bestHand.Cards) != "6s 7s 9s Ks As" {
+		t.Errorf("expected spade flush but got %s [%s]", bestHand.Value, FormatCards(bestHand.Cards))
+	}
+}
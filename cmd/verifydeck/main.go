@@ -0,0 +1,74 @@
+// verifydeck은 핸드가 끝난 후 공개된 ServerSeed로 덱 순서를 검증하는 CLI
+//
+//	go run ./cmd/verifydeck -server-seed <seed> -commitment <hash> -client-seeds "seed1,seed2" [-dealt "As Kd 7c"] [-short-deck]
+//
+// -dealt를 주면 덱에서 나온 카드들이 시드로 만든 덱의 순서와 같은지도 확인함
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/PudgeKim/go-holdem/card"
+)
+
+func main() {
+	serverSeed := flag.String("server-seed", "", "revealed server seed")
+	commitment := flag.String("commitment", "", "seed commitment published before the hand")
+	clientSeeds := flag.String("client-seeds", "", "comma separated client seeds in the order they were used")
+	dealt := flag.String("dealt", "", "cards dealt from the deck in order (e.g. \"As Kd 7c\")")
+	shortDeck := flag.Bool("short-deck", false, "the hand was played with a 36-card short deck")
+	flag.Parse()
+
+	if *serverSeed == "" || *commitment == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if card.CommitSeed(*serverSeed) != strings.ToLower(*commitment) {
+		fmt.Println("FAIL: server seed doesn't match the commitment")
+		os.Exit(1)
+	}
+	fmt.Println("OK: server seed matches the commitment")
+
+	var seeds []string
+	if *clientSeeds != "" {
+		seeds = strings.Split(*clientSeeds, ",")
+	}
+
+	// 덱은 마지막 카드부터 나가므로 뒤집어서 나오는 순서대로 보여줌
+	variant := card.Standard
+	if *shortDeck {
+		variant = card.ShortDeck
+	}
+
+	deck := *card.DeriveDeck(*serverSeed, seeds, card.WithVariant(variant))
+	dealOrder := make([]card.Card, len(deck))
+	for i, c := range deck {
+		dealOrder[len(deck)-1-i] = c
+	}
+	fmt.Println("deal order:", card.FormatCards(dealOrder))
+
+	if *dealt == "" {
+		return
+	}
+
+	cards, err := card.ParseCards(*dealt)
+	if err != nil {
+		fmt.Println("invalid -dealt:", err.Error())
+		os.Exit(2)
+	}
+	if len(cards) > len(dealOrder) {
+		fmt.Println("FAIL: more cards were dealt than the deck has")
+		os.Exit(1)
+	}
+	for i, c := range cards {
+		if dealOrder[i] != c {
+			fmt.Printf("FAIL: card #%d should be %s but got %s\n", i+1, dealOrder[i], c)
+			os.Exit(1)
+		}
+	}
+	fmt.Println("OK: dealt cards match the derived deck")
+}
@@ -0,0 +1,307 @@
+package entity
+
+import "github.com/PudgeKim/go-holdem/errors/gameerror"
+
+type ActionType string
+
+const (
+	Fold  ActionType = "Fold"
+	Check ActionType = "Check"
+	Call  ActionType = "Call"
+	Bet   ActionType = "Bet"   // 이번 스트릿에 아무도 베팅하지 않았을 때 처음 거는 베팅
+	Raise ActionType = "Raise" // 이미 걸린 베팅보다 더 거는 경우
+	AllIn ActionType = "AllIn"
+)
+
+// Action은 플레이어가 자신의 턴에 하는 행동
+// Amount는 이번 액션으로 새로 내는 금액 (Bet, Raise에서만 사용하고 Call, AllIn은 자동으로 계산됨)
+// 예를 들어 20이 걸려있고 이미 10을 낸 플레이어가 50으로 레이즈하려면 Amount는 40
+type Action struct {
+	Type   ActionType `json:"type"`
+	Amount uint64     `json:"amount"`
+}
+
+// LegalAction은 현재 플레이어가 할 수 있는 액션과 낼 수 있는 금액의 범위
+type LegalAction struct {
+	Type      ActionType `json:"type"`
+	MinAmount uint64     `json:"min_amount,omitempty"`
+	MaxAmount uint64     `json:"max_amount,omitempty"`
+}
+
+// ActionResult는 액션을 처리한 결과
+type ActionResult struct {
+	Action     Action // 실제로 처리된 액션 (Call, AllIn의 금액이 채워지고 스택을 모두 낸 경우 AllIn으로 바뀜)
+	IsRoundEnd bool   // true면 이번 스트릿의 베팅이 끝나서 다음 스트릿으로 넘어가야함
+	IsHandOver bool   // true면 다른 플레이어가 모두 폴드해서 남은 스트릿 없이 바로 핸드를 끝내야함
+	IsRunout   bool   // true면 액션할 수 있는 플레이어가 한 명 이하라서 남은 보드를 자동으로 깔고 쇼다운해야함
+}
+
+// HandleAction은 플레이어의 액션을 검증하고 처리한 뒤 다음 플레이어에게 턴을 넘김
+// 콜해야하는 금액, 최소 레이즈 금액(이번 스트릿의 마지막 레이즈 크기), 남은 스택을 기준으로 검증하고
+// 규칙에 맞지 않는 액션이면 게임 상태를 바꾸지 않고 에러를 리턴함
+func (g *Game) HandleAction(nickname string, action Action) (*ActionResult, error) {
+	defer g.freezeClock()()
+
+	p, err := g.validateTurn(nickname)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := g.validateAction(p, action)
+	if err != nil {
+		return nil, err
+	}
+
+	result := g.act(p, Action{Type: action.Type, Amount: amount})
+	recorded := result.Action
+	g.record(Event{Type: PlayerActed, Nickname: nickname, Action: &recorded})
+	return result, nil
+}
+
+// act는 검증이 끝난 액션을 현재 플레이어에게 반영하고 다음 플레이어에게 턴을 넘김
+// action.Amount는 이번 액션으로 실제로 새로 내는 금액이어야함
+func (g *Game) act(p *Player, action Action) *ActionResult {
+	amount := action.Amount
+	if action.Type == Fold {
+		p.IsDead = true
+	} else {
+		g.TotalBet += p.post(amount)
+	}
+	p.HasActed = true
+
+	// 이번 액션으로 걸린 금액이 올라간 경우
+	// 최소 레이즈 이상이면 다른 플레이어들이 다시 액션할 수 있게 하고 마지막 공격자가 됨
+	// 숏스택 올인처럼 최소 레이즈보다 작으면 베팅이 다시 열리지 않으므로 콜 금액만 올라감
+	if p.CurrentBet > g.CurrentBet {
+		if raise := p.CurrentBet - g.CurrentBet; raise >= g.MinRaise {
+			g.MinRaise = raise
+			for _, other := range g.Players {
+				if other != p {
+					other.HasActed = false
+				}
+			}
+			g.BetLeaderIdx = g.CurrentPlayerIdx
+			g.AggressorSeat = int(p.Seat)
+		}
+		g.CurrentBet = p.CurrentBet
+	}
+
+	result := &ActionResult{Action: Action{Type: action.Type, Amount: amount}}
+	if p.IsAllIn {
+		result.Action.Type = AllIn
+	}
+	g.passTurn(result)
+	return result
+}
+
+// passTurn은 베팅 라운드가 끝났으면 타이머를 멈추고 결과에 표시하고, 아니면 다음 플레이어에게 턴을 넘김
+func (g *Game) passTurn(result *ActionResult) {
+	if g.isBettingRoundOver() {
+		// 다음 스트릿의 타이머는 ResetBettingRound에서 다시 시작됨
+		g.stopActionClock()
+		result.IsRoundEnd = true
+		result.IsHandOver = g.IsUncontested()
+		result.IsRunout = g.IsRunout()
+		return
+	}
+
+	if nextIdx, ok := g.nextToAct(g.CurrentPlayerIdx); ok {
+		g.CurrentPlayerIdx = nextIdx
+	}
+	g.startActionClock()
+}
+
+// LegalActions는 플레이어가 지금 할 수 있는 액션들을 리턴함
+func (g *Game) LegalActions(p *Player) []LegalAction {
+	if p == nil || !p.IsReady || p.IsDead || p.IsLeft || p.IsAllIn {
+		return nil
+	}
+
+	toCall := g.amountToCall(p)
+	stack := p.Stack()
+
+	actions := []LegalAction{{Type: Fold}}
+	if toCall == 0 {
+		actions = append(actions, LegalAction{Type: Check})
+	} else if toCall < stack {
+		actions = append(actions, LegalAction{Type: Call, MinAmount: toCall, MaxAmount: toCall})
+	}
+
+	if g.CurrentBet == 0 && stack > g.BigBlindAmount() {
+		actions = append(actions, LegalAction{Type: Bet, MinAmount: g.BigBlindAmount(), MaxAmount: stack})
+	}
+	canRaise := p.canReopen(toCall)
+	if g.CurrentBet > 0 && canRaise && stack > toCall+g.MinRaise {
+		actions = append(actions, LegalAction{Type: Raise, MinAmount: toCall + g.MinRaise, MaxAmount: stack})
+	}
+
+	if stack > 0 && (canRaise || stack <= toCall) {
+		actions = append(actions, LegalAction{Type: AllIn, MinAmount: stack, MaxAmount: stack})
+	}
+	return actions
+}
+
+// ResetBettingRound는 다음 스트릿의 베팅을 위해 베팅 상태를 초기화함
+// 플랍부터는 버튼 왼쪽에서 액션할 수 있는 첫 플레이어부터 시작함
+func (g *Game) ResetBettingRound() {
+	g.ClearPlayersCurrentBet()
+	for _, p := range g.Players {
+		p.HasActed = false
+	}
+	g.CurrentBet = 0
+	g.MinRaise = g.BigBlindAmount()
+
+	g.CurrentPlayerIdx = g.firstToActFrom(g.leftOfButtonIdx())
+	g.BetLeaderIdx = g.CurrentPlayerIdx
+	g.AggressorSeat = NoSeat
+	g.startActionClock()
+}
+
+func (g *Game) validateTurn(nickname string) (*Player, error) {
+	if !g.IsStarted {
+		return nil, gameerror.GameNotStarted
+	}
+
+	p := g.FindPlayer(nickname)
+	if p == nil {
+		return nil, gameerror.NoPlayerExists
+	}
+	if !p.IsReady {
+		return nil, gameerror.PlayerNotReady
+	}
+	if p.IsDead {
+		return nil, gameerror.DeadPlayer
+	}
+	if p.IsLeft {
+		return nil, gameerror.PlayerLeft
+	}
+	if g.Players[g.CurrentPlayerIdx] != p || p.IsAllIn {
+		return nil, gameerror.InvalidPlayerTurn
+	}
+	return p, nil
+}
+
+// validateAction은 액션이 규칙에 맞는지 확인하고 이번 액션으로 새로 내야하는 금액을 리턴함
+func (g *Game) validateAction(p *Player, action Action) (uint64, error) {
+	toCall := g.amountToCall(p)
+	stack := p.Stack()
+
+	switch action.Type {
+	case Fold:
+		return 0, nil
+
+	case Check:
+		if toCall > 0 {
+			return 0, gameerror.CheckNotAllowed
+		}
+		return 0, nil
+
+	case Call:
+		if toCall == 0 {
+			return 0, gameerror.CallNotAllowed
+		}
+		if toCall > stack {
+			return 0, gameerror.OverBalance
+		}
+		return toCall, nil
+
+	case Bet:
+		if g.CurrentBet > 0 {
+			return 0, gameerror.BetNotAllowed
+		}
+		if action.Amount > stack {
+			return 0, gameerror.OverBalance
+		}
+		// 스택이 빅블라인드보다 적으면 전부 거는 것만 가능
+		if action.Amount < g.BigBlindAmount() && action.Amount < stack {
+			return 0, gameerror.LowBetting
+		}
+		return action.Amount, nil
+
+	case Raise:
+		if g.CurrentBet == 0 || !p.canReopen(toCall) {
+			return 0, gameerror.RaiseNotAllowed
+		}
+		if action.Amount > stack {
+			return 0, gameerror.OverBalance
+		}
+		if action.Amount <= toCall {
+			return 0, gameerror.LowBetting
+		}
+		// 스택을 모두 거는 경우는 최소 레이즈보다 작아도 됨
+		if action.Amount-toCall < g.MinRaise && action.Amount < stack {
+			return 0, gameerror.LowRaise
+		}
+		return action.Amount, nil
+
+	case AllIn:
+		if stack == 0 {
+			return 0, gameerror.OverBalance
+		}
+		// 콜 금액보다 많은 올인은 레이즈이므로 레이즈할 수 있을 때만 가능
+		if stack > toCall && !p.canReopen(toCall) {
+			return 0, gameerror.RaiseNotAllowed
+		}
+		return stack, nil
+	}
+	return 0, gameerror.InvalidActionType
+}
+
+// 이미 액션한 플레이어는 그 뒤에 최소 레이즈 이상의 레이즈가 있어야만 다시 레이즈할 수 있음
+// (최소 레이즈 이상이면 HasActed가 초기화되므로 HasActed가 true인데 콜할 금액이 있으면 최소 레이즈보다 작은 올인이 있었던 것)
+func (p *Player) canReopen(toCall uint64) bool {
+	return !p.HasActed || toCall == 0
+}
+
+func (g *Game) amountToCall(p *Player) uint64 {
+	if g.CurrentBet <= p.CurrentBet {
+		return 0
+	}
+	return g.CurrentBet - p.CurrentBet
+}
+
+// 죽거나 나가지 않았고 올인하지 않아서 아직 액션을 할 수 있는 플레이어인지
+func (p *Player) canAct() bool {
+	return p.IsReady && !p.IsDead && !p.IsLeft && !p.IsAllIn
+}
+
+// 한 명만 남았거나, 액션할 수 있는 모든 플레이어가 액션을 했고 같은 금액을 걸었으면 베팅이 끝남
+func (g *Game) isBettingRoundOver() bool {
+	if len(g.GetValidPlayers()) <= 1 {
+		return true
+	}
+
+	for _, p := range g.Players {
+		if !p.canAct() {
+			continue
+		}
+		if !p.HasActed || p.CurrentBet != g.CurrentBet {
+			return false
+		}
+	}
+	return true
+}
+
+// idx 다음 자리부터 시계 방향으로 액션할 수 있는 첫 플레이어의 인덱스
+func (g *Game) nextToAct(idx uint) (uint, bool) {
+	return g.findToAct(getNextIdx(g.Players, idx))
+}
+
+// idx 자리부터 (idx 포함) 시계 방향으로 액션할 수 있는 첫 플레이어의 인덱스
+// 아무도 액션할 수 없으면 idx를 그대로 리턴
+func (g *Game) firstToActFrom(idx uint) uint {
+	if found, ok := g.findToAct(idx); ok {
+		return found
+	}
+	return idx
+}
+
+func (g *Game) findToAct(idx uint) (uint, bool) {
+	for i := 0; i < len(g.Players); i++ {
+		if g.Players[idx].canAct() {
+			return idx, true
+		}
+		idx = getNextIdx(g.Players, idx)
+	}
+	return 0, false
+}
@@ -0,0 +1,339 @@
+package entity
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/google/uuid"
+)
+
+// p0부터 순서대로 앉은 게임을 시작함 (SmallBlind 10, BigBlind 20)
+// 3명 이상이면 p0이 SmallBlind, p1이 BigBlind, p2가 첫 플레이어이고
+// 헤즈업이면 p0이 SmallBlind이자 첫 플레이어
+func newActionGame(balances ...uint64) *Game {
+	host := NewPlayer(0, "p0", 10000, balances[0])
+	host.IsReady = true
+	game := NewGame(uuid.New(), 7, host, 10)
+	for i := 1; i < len(balances); i++ {
+		p := NewPlayer(int64(i), fmt.Sprintf("p%d", i), 10000, balances[i])
+		p.IsReady = true
+		if err := game.AddPlayer(p); err != nil {
+			panic(err)
+		}
+	}
+	if err := game.StartGame(); err != nil {
+		panic(err)
+	}
+	return game
+}
+
+type actionStep struct {
+	player string
+	action Action
+	err    error
+}
+
+func TestHandleAction(t *testing.T) {
+	tests := []struct {
+		name     string
+		balances []uint64
+		steps    []actionStep
+	}{
+		{
+			name:     "check facing a bet",
+			balances: []uint64{1000, 1000, 1000},
+			steps:    []actionStep{{"p2", Action{Type: Check}, gameerror.CheckNotAllowed}},
+		},
+		{
+			name:     "bet when there is already a bet",
+			balances: []uint64{1000, 1000, 1000},
+			steps:    []actionStep{{"p2", Action{Type: Bet, Amount: 40}, gameerror.BetNotAllowed}},
+		},
+		{
+			name:     "raise below the big blind",
+			balances: []uint64{1000, 1000, 1000},
+			steps:    []actionStep{{"p2", Action{Type: Raise, Amount: 30}, gameerror.LowRaise}},
+		},
+		{
+			name:     "raise not more than the amount to call",
+			balances: []uint64{1000, 1000, 1000},
+			steps:    []actionStep{{"p2", Action{Type: Raise, Amount: 20}, gameerror.LowBetting}},
+		},
+		{
+			name:     "raise more than the stack",
+			balances: []uint64{1000, 1000, 1000},
+			steps:    []actionStep{{"p2", Action{Type: Raise, Amount: 1001}, gameerror.OverBalance}},
+		},
+		{
+			name:     "call more than the stack",
+			balances: []uint64{1000, 1000, 15},
+			steps:    []actionStep{{"p2", Action{Type: Call}, gameerror.OverBalance}},
+		},
+		{
+			name:     "out of turn",
+			balances: []uint64{1000, 1000, 1000},
+			steps:    []actionStep{{"p0", Action{Type: Call}, gameerror.InvalidPlayerTurn}},
+		},
+		{
+			name:     "unknown action",
+			balances: []uint64{1000, 1000, 1000},
+			steps:    []actionStep{{"p2", Action{Type: "Limp"}, gameerror.InvalidActionType}},
+		},
+		{
+			name:     "folded player can't act",
+			balances: []uint64{1000, 1000, 1000},
+			steps: []actionStep{
+				{"p2", Action{Type: Fold}, nil},
+				{"p2", Action{Type: Call}, gameerror.DeadPlayer},
+			},
+		},
+		{
+			name:     "re-raise must be at least the last raise",
+			balances: []uint64{1000, 1000, 1000},
+			steps: []actionStep{
+				{"p2", Action{Type: Raise, Amount: 60}, nil},                 // 60으로 40 레이즈
+				{"p0", Action{Type: Raise, Amount: 80}, gameerror.LowRaise},  // 90으로 30 레이즈
+				{"p0", Action{Type: Raise, Amount: 90}, nil},                 // 100으로 40 레이즈
+				{"p1", Action{Type: Raise, Amount: 100}, gameerror.LowRaise}, // 120으로 20 레이즈
+				{"p1", Action{Type: Call}, nil},
+			},
+		},
+		{
+			name:     "big blind can't call its own blind",
+			balances: []uint64{1000, 1000, 1000},
+			steps: []actionStep{
+				{"p2", Action{Type: Call}, nil},
+				{"p0", Action{Type: Call}, nil},
+				{"p1", Action{Type: Call}, gameerror.CallNotAllowed},
+				{"p1", Action{Type: Check}, nil},
+			},
+		},
+		{
+			name:     "short all-in doesn't reopen raising",
+			balances: []uint64{1000, 1000, 1000, 70},
+			steps: []actionStep{
+				{"p2", Action{Type: Raise, Amount: 60}, nil},
+				{"p3", Action{Type: AllIn}, nil}, // 70으로 10만 올려서 최소 레이즈보다 작음
+				{"p0", Action{Type: Fold}, nil},
+				{"p1", Action{Type: Fold}, nil},
+				{"p2", Action{Type: Raise, Amount: 100}, gameerror.RaiseNotAllowed},
+				{"p2", Action{Type: AllIn}, gameerror.RaiseNotAllowed},
+				{"p2", Action{Type: Call}, nil},
+			},
+		},
+		{
+			name:     "all-in below the minimum raise",
+			balances: []uint64{1000, 1000, 35},
+			steps: []actionStep{
+				{"p2", Action{Type: Raise, Amount: 35}, nil},
+				{"p0", Action{Type: Raise, Amount: 44}, gameerror.LowRaise}, // 최소 레이즈는 그대로 20이므로 55부터 가능
+				{"p0", Action{Type: Raise, Amount: 25}, gameerror.LowBetting},
+				{"p0", Action{Type: Call}, nil},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		game := newActionGame(tt.balances...)
+		for i, step := range tt.steps {
+			_, err := game.HandleAction(step.player, step.action)
+			if err != step.err {
+				t.Errorf("%s: step %d (%s %s %d) expected error %v but got %v",
+					tt.name, i, step.player, step.action.Type, step.action.Amount, step.err, err)
+				break
+			}
+		}
+	}
+}
+
+func TestHandleActionBettingRound(t *testing.T) {
+	game := newActionGame(1000, 1000, 1000)
+
+	steps := []struct {
+		player     string
+		action     Action
+		result     Action
+		isRoundEnd bool
+		next       string
+	}{
+		{"p2", Action{Type: Raise, Amount: 60}, Action{Type: Raise, Amount: 60}, false, "p0"},
+		{"p0", Action{Type: Call}, Action{Type: Call, Amount: 50}, false, "p1"},
+		{"p1", Action{Type: Raise, Amount: 140}, Action{Type: Raise, Amount: 140}, false, "p2"},
+		{"p2", Action{Type: AllIn}, Action{Type: AllIn, Amount: 940}, false, "p0"},
+		{"p0", Action{Type: Fold}, Action{Type: Fold}, false, "p1"},
+		{"p1", Action{Type: Call}, Action{Type: AllIn, Amount: 840}, true, "p1"},
+	}
+
+	for i, step := range steps {
+		result, err := game.HandleAction(step.player, step.action)
+		if err != nil {
+			t.Fatalf("step %d: unexpected error %v", i, err)
+		}
+		if result.Action != step.result || result.IsRoundEnd != step.isRoundEnd {
+			t.Errorf("step %d: expected %v (round end %v) but got %v (round end %v)",
+				i, step.result, step.isRoundEnd, result.Action, result.IsRoundEnd)
+		}
+		if next := game.Players[game.CurrentPlayerIdx].Nickname; next != step.next {
+			t.Errorf("step %d: expected next player %s but got %s", i, step.next, next)
+		}
+	}
+
+	if game.TotalBet != 2060 {
+		t.Errorf("expected total bet 2060 but got %d", game.TotalBet)
+	}
+}
+
+func TestAggressor(t *testing.T) {
+	tests := []struct {
+		name      string
+		balances  []uint64
+		steps     []actionStep
+		aggressor string
+	}{
+		{
+			name:      "raise",
+			balances:  []uint64{1000, 1000, 1000, 1000},
+			steps:     []actionStep{{"p2", Action{Type: Raise, Amount: 60}, nil}},
+			aggressor: "p2",
+		},
+		{
+			name:     "short all-in over a bet",
+			balances: []uint64{1000, 1000, 1000, 70},
+			steps: []actionStep{
+				{"p2", Action{Type: Raise, Amount: 60}, nil},
+				{"p3", Action{Type: AllIn}, nil}, // 70으로 10만 올려서 베팅이 다시 열리지 않음
+			},
+			aggressor: "p2",
+		},
+		{
+			name:     "all-in with a full raise",
+			balances: []uint64{1000, 1000, 1000, 200},
+			steps: []actionStep{
+				{"p2", Action{Type: Raise, Amount: 60}, nil},
+				{"p3", Action{Type: AllIn}, nil}, // 200으로 140 레이즈
+			},
+			aggressor: "p3",
+		},
+	}
+
+	for _, tt := range tests {
+		game := newActionGame(tt.balances...)
+		for i, step := range tt.steps {
+			if _, err := game.HandleAction(step.player, step.action); err != nil {
+				t.Fatalf("%s: step %d: unexpected error %v", tt.name, i, err)
+			}
+		}
+
+		p := game.FindPlayer(tt.aggressor)
+		if game.AggressorSeat != int(p.Seat) {
+			t.Errorf("%s: aggressor should be at seat %d but got %d", tt.name, p.Seat, game.AggressorSeat)
+		}
+		if leader := game.Players[game.BetLeaderIdx]; leader != p {
+			t.Errorf("%s: bet leader should be %s but got %s", tt.name, p.Nickname, leader.Nickname)
+		}
+	}
+}
+
+func TestLegalActions(t *testing.T) {
+	game := newActionGame(1000, 1000, 1000)
+
+	expected := []LegalAction{
+		{Type: Fold},
+		{Type: Call, MinAmount: 20, MaxAmount: 20},
+		{Type: Raise, MinAmount: 40, MaxAmount: 1000},
+		{Type: AllIn, MinAmount: 1000, MaxAmount: 1000},
+	}
+	if actions := game.LegalActions(game.Players[2]); !reflect.DeepEqual(actions, expected) {
+		t.Errorf("first player: expected %v but got %v", expected, actions)
+	}
+
+	for _, step := range []actionStep{{"p2", Action{Type: Call}, nil}, {"p0", Action{Type: Call}, nil}} {
+		if _, err := game.HandleAction(step.player, step.action); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// BigBlind는 모두 콜했으면 체크하거나 레이즈할 수 있음
+	expected = []LegalAction{
+		{Type: Fold},
+		{Type: Check},
+		{Type: Raise, MinAmount: 20, MaxAmount: 980},
+		{Type: AllIn, MinAmount: 980, MaxAmount: 980},
+	}
+	if actions := game.LegalActions(game.Players[1]); !reflect.DeepEqual(actions, expected) {
+		t.Errorf("big blind option: expected %v but got %v", expected, actions)
+	}
+
+	result, err := game.HandleAction("p1", Action{Type: Check})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.IsRoundEnd {
+		t.Fatal("expected preflop betting to end")
+	}
+
+	// 플랍부터는 SmallBlind부터 액션하고 아무도 베팅하지 않았으므로 Bet을 할 수 있음
+	game.ResetBettingRound()
+	if first := game.Players[game.CurrentPlayerIdx]; first.Nickname != "p0" {
+		t.Errorf("expected p0 to act first after the flop but got %s", first.Nickname)
+	}
+	expected = []LegalAction{
+		{Type: Fold},
+		{Type: Check},
+		{Type: Bet, MinAmount: 20, MaxAmount: 980},
+		{Type: AllIn, MinAmount: 980, MaxAmount: 980},
+	}
+	if actions := game.LegalActions(game.Players[0]); !reflect.DeepEqual(actions, expected) {
+		t.Errorf("after the flop: expected %v but got %v", expected, actions)
+	}
+
+	if _, err := game.HandleAction("p0", Action{Type: Raise, Amount: 40}); err != gameerror.RaiseNotAllowed {
+		t.Errorf("expected %v but got %v", gameerror.RaiseNotAllowed, err)
+	}
+	if _, err := game.HandleAction("p0", Action{Type: Bet, Amount: 10}); err != gameerror.LowBetting {
+		t.Errorf("expected %v but got %v", gameerror.LowBetting, err)
+	}
+}
+
+func TestUncontestedPot(t *testing.T) {
+	game := newActionGame(1000, 1000, 1000)
+
+	// p2가 레이즈하고 나머지가 모두 폴드하면 플랍을 보지 않고 핸드가 끝남
+	steps := []actionStep{
+		{"p2", Action{Type: Raise, Amount: 60}, nil},
+		{"p0", Action{Type: Fold}, nil},
+	}
+	for _, step := range steps {
+		result, err := game.HandleAction(step.player, step.action)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.IsHandOver {
+			t.Fatalf("%s: hand should not be over yet", step.player)
+		}
+	}
+
+	result, err := game.HandleAction("p1", Action{Type: Fold})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.IsRoundEnd || !result.IsHandOver || !game.IsUncontested() {
+		t.Fatal("hand should be over when everyone else folds")
+	}
+	if !game.ActionDeadline.IsZero() {
+		t.Error("clock should stop when hand is over")
+	}
+
+	_, winners, losers := game.Settle()
+	if len(winners) != 1 || winners[0].Nickname != "p2" || len(losers) != 2 {
+		t.Fatalf("p2 should win uncontested pot but got winners %v", winners)
+	}
+	expected := map[string]uint64{"p0": 990, "p1": 980, "p2": 1030}
+	for nickname, balance := range expected {
+		if p := game.FindPlayer(nickname); p.GameBalance != balance {
+			t.Errorf("%s: expected balance %d but got %d", nickname, balance, p.GameBalance)
+		}
+	}
+}
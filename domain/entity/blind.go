@@ -0,0 +1,96 @@
+package entity
+
+// SmallBlindAmount는 SmallBlind가 내야하는 금액 (MinBetAmount)
+func (g *Game) SmallBlindAmount() uint64 {
+	return g.MinBetAmount
+}
+
+// BigBlindAmount는 BigBlind가 내야하는 금액 (SmallBlind의 2배)
+func (g *Game) BigBlindAmount() uint64 {
+	return g.MinBetAmount * 2
+}
+
+// postBlinds는 핸드 시작 시 앤티와 블라인드를 걸어줌
+// 앤티는 팟에만 들어가고 콜해야하는 금액에는 포함되지 않으므로 CurrentBet에는 반영하지 않음
+// 블라인드를 다 낼 돈이 없는 플레이어는 가진 만큼만 내고 올인 처리됨
+func (g *Game) postBlinds() {
+	if g.Ante > 0 {
+		for _, p := range g.GetValidPlayers() {
+			g.postBlind(p, PostAnte, g.Ante)
+		}
+	}
+
+	// DeadButton 규칙으로 SmallBlind 자리가 비어있으면 SmallBlind는 아무도 내지 않음
+	if smallBlind := g.GetSmallBlind(); smallBlind != nil {
+		g.postBlind(smallBlind, PostSmallBlind, g.SmallBlindAmount())
+	}
+	g.postBlind(g.GetBigBlind(), PostBigBlind, g.BigBlindAmount())
+
+	g.postMissedBlinds()
+
+	// BigBlind가 숏스택이라 다 못냈더라도 다른 플레이어들은 BigBlind 금액을 콜해야함
+	g.CurrentBet = g.BigBlindAmount()
+}
+
+// postMissedBlinds는 자리를 비운 동안 블라인드를 놓친 플레이어가 다시 참여할 때 놓친 블라인드를 걷음
+// BigBlind는 라이브로 걸려서 콜 금액에 포함되고 SmallBlind는 데드로 팟에만 들어감
+// 이번 핸드에 블라인드를 내는 플레이어는 이미 블라인드를 냈으므로 따로 내지 않음
+func (g *Game) postMissedBlinds() {
+	for idx, p := range g.Players {
+		if !p.IsReady || (!p.MissedSmallBlind && !p.MissedBigBlind) {
+			continue
+		}
+
+		isBlind := uint(idx) == g.BigBlindIdx || (!g.IsSmallBlindDead && uint(idx) == g.SmallBlindIdx)
+		if !isBlind {
+			if p.MissedBigBlind {
+				g.postBlind(p, PostMissedBigBlind, g.BigBlindAmount())
+			}
+			if p.MissedSmallBlind {
+				g.postBlind(p, PostMissedSmallBlind, g.SmallBlindAmount())
+			}
+		}
+		p.MissedSmallBlind = false
+		p.MissedBigBlind = false
+	}
+}
+
+// postBlind는 플레이어가 앤티나 블라인드를 내게 하고 실제로 낸 금액을 리턴함
+func (g *Game) postBlind(p *Player, blind BlindType, amount uint64) uint64 {
+	if stack := p.Stack(); amount > stack {
+		amount = stack
+	}
+	g.record(Event{Type: BlindPosted, Nickname: p.Nickname, Blind: blind, Amount: amount})
+	g.applyBlind(p, blind, amount)
+	return amount
+}
+
+// applyBlind는 실제로 낸 금액 posted를 팟에 넣음
+// 앤티와 놓친 SmallBlind는 이번 스트릿의 베팅이 아니므로 콜 금액 계산에서 빠져야함
+func (g *Game) applyBlind(p *Player, blind BlindType, posted uint64) {
+	g.TotalBet += p.post(posted)
+	if blind == PostAnte || blind == PostMissedSmallBlind {
+		p.CurrentBet -= posted
+	}
+}
+
+// Stack은 이번 핸드에서 더 베팅할 수 있는 금액
+// 베팅액은 핸드가 끝날 때 GameBalance에서 한번에 정산되므로 아직 빠지지 않은 TotalBet을 빼서 계산함
+func (p *Player) Stack() uint64 {
+	if p.TotalBet >= p.GameBalance {
+		return 0
+	}
+	return p.GameBalance - p.TotalBet
+}
+
+// post는 amount만큼 베팅하고 실제로 낸 금액을 리턴함
+// 남은 돈이 amount 이하면 가진 만큼만 내고 올인 처리됨
+func (p *Player) post(amount uint64) uint64 {
+	if stack := p.Stack(); amount >= stack {
+		amount = stack
+		p.IsAllIn = true
+	}
+	p.CurrentBet += amount
+	p.TotalBet += amount
+	return amount
+}
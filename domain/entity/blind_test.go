@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPostBlinds(t *testing.T) {
+	tests := []struct {
+		name        string
+		balances    []uint64
+		ante        uint64
+		currentBets []uint64 // 플레이어 순서대로 이번 스트릿에 걸린 금액
+		totalBets   []uint64
+		allIn       []bool
+		pot         uint64
+	}{
+		{
+			name:        "small blind and big blind",
+			balances:    []uint64{1000, 1000, 1000},
+			currentBets: []uint64{10, 20, 0},
+			totalBets:   []uint64{10, 20, 0},
+			allIn:       []bool{false, false, false},
+			pot:         30,
+		},
+		{
+			name:        "heads up",
+			balances:    []uint64{1000, 1000},
+			currentBets: []uint64{10, 20},
+			totalBets:   []uint64{10, 20},
+			allIn:       []bool{false, false},
+			pot:         30,
+		},
+		{
+			name:        "ante is not part of current bet",
+			balances:    []uint64{1000, 1000, 1000},
+			ante:        5,
+			currentBets: []uint64{10, 20, 0},
+			totalBets:   []uint64{15, 25, 5},
+			allIn:       []bool{false, false, false},
+			pot:         45,
+		},
+		{
+			name:        "short big blind posts partial blind and goes all-in",
+			balances:    []uint64{1000, 15, 1000},
+			currentBets: []uint64{10, 15, 0},
+			totalBets:   []uint64{10, 15, 0},
+			allIn:       []bool{false, true, false},
+			pot:         25,
+		},
+		{
+			name:        "short stack goes all-in with ante",
+			balances:    []uint64{1000, 1000, 3},
+			ante:        5,
+			currentBets: []uint64{10, 20, 0},
+			totalBets:   []uint64{15, 25, 3},
+			allIn:       []bool{false, false, true},
+			pot:         43,
+		},
+		{
+			name:        "small blind all-in from ante posts nothing",
+			balances:    []uint64{5, 1000, 1000},
+			ante:        5,
+			currentBets: []uint64{0, 20, 0},
+			totalBets:   []uint64{5, 25, 5},
+			allIn:       []bool{true, false, false},
+			pot:         35,
+		},
+	}
+
+	for _, tt := range tests {
+		host := NewPlayer(0, "p0", 10000, tt.balances[0])
+		host.IsReady = true
+		game := NewGame(uuid.New(), 7, host, 10, WithAnte(tt.ante))
+		for i := 1; i < len(tt.balances); i++ {
+			p := NewPlayer(int64(i), fmt.Sprintf("p%d", i), 10000, tt.balances[i])
+			p.IsReady = true
+			if err := game.AddPlayer(p); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := game.StartGame(); err != nil {
+			t.Fatalf("%s: %s", tt.name, err.Error())
+		}
+
+		for i, p := range game.Players {
+			if p.CurrentBet != tt.currentBets[i] || p.TotalBet != tt.totalBets[i] || p.IsAllIn != tt.allIn[i] {
+				t.Errorf("%s: %s should have (%d, %d, %t) but got (%d, %d, %t)", tt.name, p.Nickname,
+					tt.currentBets[i], tt.totalBets[i], tt.allIn[i], p.CurrentBet, p.TotalBet, p.IsAllIn)
+			}
+		}
+		if game.TotalBet != tt.pot {
+			t.Errorf("%s: pot should be %d but got %d", tt.name, tt.pot, game.TotalBet)
+		}
+		if game.CurrentBet != 20 {
+			t.Errorf("%s: current bet should be the big blind", tt.name)
+		}
+		if !game.IsStarted {
+			t.Errorf("%s: game should be started", tt.name)
+		}
+	}
+}
+
+func TestFirstPlayerAllInFromBlinds(t *testing.T) {
+	tests := []struct {
+		name        string
+		balances    []uint64
+		ante        uint64
+		firstPlayer string // 비어있으면 아무도 액션할 필요가 없어서 바로 런아웃함
+	}{
+		{
+			name:        "first player all-in from ante is skipped",
+			balances:    []uint64{1000, 1000, 3},
+			ante:        5,
+			firstPlayer: "p0",
+		},
+		{
+			name:     "heads up small blind all-in runs out",
+			balances: []uint64{5, 1000},
+		},
+		{
+			name:        "heads up big blind all-in still needs small blind to call",
+			balances:    []uint64{1000, 15},
+			firstPlayer: "p0",
+		},
+	}
+
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	for _, tt := range tests {
+		host := NewPlayer(0, "p0", 10000, tt.balances[0])
+		game := NewGame(uuid.New(), 7, host, 10, WithAnte(tt.ante), WithActionTimeout(10*time.Second), WithClock(clock.Now))
+		for i := 1; i < len(tt.balances); i++ {
+			if err := game.AddPlayer(NewPlayer(int64(i), fmt.Sprintf("p%d", i), 10000, tt.balances[i])); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, p := range game.Players {
+			if err := game.SetReady(p.Nickname, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := game.StartGame(); err != nil {
+			t.Fatalf("%s: %s", tt.name, err.Error())
+		}
+
+		// 이벤트 로그로 다시 만든 게임도 같은 플레이어가 먼저 액션해야함
+		replayed, err := ReplayGame(game.PendingEvents())
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected, _ := game.MarshalBinary()
+		if data, _ := replayed.MarshalBinary(); string(data) != string(expected) {
+			t.Errorf("%s: replayed game should be\n%s\nbut got\n%s", tt.name, expected, data)
+		}
+
+		if tt.firstPlayer == "" {
+			if !game.IsRunout() {
+				t.Fatalf("%s: hand should run out without any action", tt.name)
+			}
+			result, err := game.RunOut()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Phase != PhaseHandComplete {
+				t.Errorf("%s: runout should complete the hand but got %s", tt.name, result.Phase)
+			}
+			continue
+		}
+
+		first := game.Players[game.CurrentPlayerIdx]
+		if first.Nickname != tt.firstPlayer || game.GetFirstPlayer() != first || game.IsRunout() {
+			t.Fatalf("%s: %s should act first but got %s", tt.name, tt.firstPlayer, first.Nickname)
+		}
+		if game.ActionDeadline.IsZero() {
+			t.Errorf("%s: first player's clock should be running", tt.name)
+		}
+		if _, err := game.Apply(tt.firstPlayer, Action{Type: Call}); err != nil {
+			t.Errorf("%s: first player should be able to act but got %v", tt.name, err)
+		}
+	}
+}
+
+func TestStartGameWithoutEnoughPlayers(t *testing.T) {
+	host := NewPlayer(0, "host", 1000, 1000)
+	host.IsReady = true
+	game := NewGame(uuid.New(), 7, host, 10)
+
+	if err := game.StartGame(); err == nil {
+		t.Error("game can't start with one player")
+	}
+	if game.IsStarted || host.TotalBet != 0 {
+		t.Error("blinds should not be posted when game can't start")
+	}
+}
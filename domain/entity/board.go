@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+)
+
+// DealFlop은 한장을 버리고 플랍 3장을 깔아줌
+func (g *Game) DealFlop() error {
+	return g.dealBoard(0, 3)
+}
+
+// DealTurn은 한장을 버리고 턴 1장을 깔아줌
+func (g *Game) DealTurn() error {
+	return g.dealBoard(3, 1)
+}
+
+// DealRiver는 한장을 버리고 리버 1장을 깔아줌
+func (g *Game) DealRiver() error {
+	return g.dealBoard(4, 1)
+}
+
+// DealNextStreet은 Phase에 맞춰 다음 스트릿의 카드를 깔고 Phase를 다음 스트릿으로 바꿈
+// 리버 이후에는 깔 카드가 없으므로 InvalidBoardStreet을 리턴함
+func (g *Game) DealNextStreet() error {
+	var next Phase
+	switch g.Phase {
+	case PhasePreflop:
+		next = PhaseFlop
+	case PhaseFlop:
+		next = PhaseTurn
+	case PhaseTurn:
+		next = PhaseRiver
+	default:
+		return gameerror.InvalidBoardStreet
+	}
+
+	boardLen := len(g.Board)
+	if err := g.dealStreet(next); err != nil {
+		return err
+	}
+	if err := g.transition(next); err != nil {
+		return err
+	}
+	g.record(Event{Type: StreetDealt, Phase: next, Cards: append([]card.Card(nil), g.Board[boardLen:]...)})
+	return nil
+}
+
+// dealStreet은 street에 깔려야하는 카드를 깔아줌 (Phase는 바꾸지 않음)
+func (g *Game) dealStreet(street Phase) error {
+	switch street {
+	case PhaseFlop:
+		return g.DealFlop()
+	case PhaseTurn:
+		return g.DealTurn()
+	case PhaseRiver:
+		return g.DealRiver()
+	}
+	return gameerror.InvalidBoardStreet
+}
+
+// 현재 보드가 boardLen장일 때만 카드를 깔 수 있음 (플랍 -> 턴 -> 리버 순서를 지키기 위해)
+func (g *Game) dealBoard(boardLen int, n int) error {
+	if len(g.Board) != boardLen {
+		return gameerror.InvalidBoardStreet
+	}
+
+	// 버릴 카드까지 남아있는지 먼저 확인해서 실패하면 덱이 바뀌지 않게 함
+	if g.Deck.Remaining() < n+1 {
+		return carderror.NotEnoughCards
+	}
+
+	if err := g.Deck.Burn(); err != nil {
+		return err
+	}
+
+	cards, err := g.Deck.Deal(n)
+	if err != nil {
+		return err
+	}
+	g.Board = append(g.Board, cards...)
+	return nil
+}
+
+// EvaluateHands는 쇼다운에서 남아있는 플레이어들의 가장 좋은 5장과 핸드의 세기를 계산함
+// 리버까지 보드가 모두 깔린 후에 Settle 전에 호출해야함
+func (g *Game) EvaluateHands() error {
+	if len(g.Board) != 5 {
+		return gameerror.InvalidBoardStreet
+	}
+
+	for _, p := range g.GetValidPlayers() {
+		cards := append(append([]card.Card{}, p.Hands...), g.Board...)
+
+		best, err := g.Variant.GetBestHand(cards)
+		if err != nil {
+			return err
+		}
+		p.BestCards = best.Cards
+		p.HandValue = best.Value
+	}
+	return nil
+}
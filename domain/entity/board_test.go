@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/google/uuid"
+)
+
+func newHeadsUpGame(deck string) (*Game, *Player, *Player) {
+	host := NewPlayer(1, "host", 1000, 1000)
+	host.IsReady = true
+	guest := NewPlayer(2, "guest", 1000, 1000)
+	guest.IsReady = true
+
+	game := NewGame(uuid.New(), 7, host, 10)
+	game.Players = append(game.Players, guest)
+	game.SmallBlindIdx, game.BigBlindIdx = 0, 1
+	game.Deck = card.NewDeckFromOrder(mustParseCards(deck))
+	return game, host, guest
+}
+
+func TestDealBoard(t *testing.T) {
+	// guest, host, guest, host 순으로 홀카드를 받고
+	// 플랍, 턴, 리버 전에 한장씩 버림 (2c, 3c, 4c)
+	game, host, guest := newHeadsUpGame("Ah Ks Ad Kd 2c Qh Js 9c 3c 7d 4c Tc")
+
+	if err := game.GiveCardsToPlayers(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := game.DealTurn(); err != gameerror.InvalidBoardStreet {
+		t.Error("turn can't be dealt before flop")
+	}
+
+	if err := game.DealFlop(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if card.FormatCards(game.Board) != "Qh Js 9c" {
+		t.Errorf("unexpected flop: %s", card.FormatCards(game.Board))
+	}
+	if err := game.DealFlop(); err != gameerror.InvalidBoardStreet {
+		t.Error("flop can't be dealt twice")
+	}
+	if err := game.EvaluateHands(); err != gameerror.InvalidBoardStreet {
+		t.Error("hands can't be evaluated before river")
+	}
+
+	if err := game.DealTurn(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := game.DealRiver(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if card.FormatCards(game.Board) != "Qh Js 9c 7d Tc" {
+		t.Errorf("unexpected board: %s", card.FormatCards(game.Board))
+	}
+	if game.Deck.Remaining() != 0 {
+		t.Error("all cards should be used")
+	}
+
+	if err := game.EvaluateHands(); err != nil {
+		t.Fatal(err.Error())
+	}
+	// host는 K-Q-J-T-9 스트레이트, guest는 A 원페어
+	if host.HandValue.Category() != card.Straight || card.FormatCards(host.BestCards) != "9c Tc Js Qh Ks" {
+		t.Errorf("host should have King-high straight but got %s [%s]", host.HandValue, card.FormatCards(host.BestCards))
+	}
+	if guest.HandValue.Category() != card.OnePair {
+		t.Errorf("guest should have one pair but got %s", guest.HandValue)
+	}
+
+	if host.HandValue.Compare(guest.HandValue) != 1 {
+		t.Error("host should win")
+	}
+
+	game.InitGame()
+	if game.Board != nil {
+		t.Error("board should be cleared for next game")
+	}
+}
+
+func TestDealBoardWithEmptyDeck(t *testing.T) {
+	game, _, _ := newHeadsUpGame("2c 3c")
+
+	if err := game.DealFlop(); err != carderror.NotEnoughCards {
+		t.Error("flop can't be dealt without enough cards")
+	}
+	if len(game.Board) != 0 || game.Deck.Remaining() != 2 {
+		t.Error("board and deck should not change when dealing fails")
+	}
+}
@@ -0,0 +1,186 @@
+package entity
+
+import "github.com/PudgeKim/go-holdem/errors/gameerror"
+
+// ButtonPolicy는 플레이어가 나가거나 돈을 모두 잃었을 때 버튼과 블라인드를 옮기는 방식
+type ButtonPolicy int
+
+const (
+	// MovingButton은 버튼이 항상 다음 플레이어에게 한칸씩 이동함
+	// 빈 자리를 건너뛰므로 블라인드를 두번 내거나 한번도 안 내는 플레이어가 생길 수 있음
+	MovingButton ButtonPolicy = iota
+	// DeadButton은 BigBlind가 항상 다음 플레이어에게 한칸씩 이동하고
+	// SmallBlind와 버튼은 이전 BigBlind, 이전 SmallBlind 자리에 놓임
+	// 그 자리의 플레이어가 나갔으면 SmallBlind는 아무도 내지 않고 버튼은 빈 자리에 놓임 (dead button)
+	DeadButton
+)
+
+// NoSeat은 아직 버튼이나 블라인드가 정해지지 않았을 때의 자리 번호
+const NoSeat = -1
+
+// WithButtonPolicy는 방의 버튼 이동 방식을 지정함 (기본값은 MovingButton)
+func WithButtonPolicy(policy ButtonPolicy) GameOption {
+	return func(g *Game) {
+		g.ButtonPolicy = policy
+	}
+}
+
+// AddPlayer는 비어있는 가장 앞 자리에 플레이어를 앉힘
+// 플레이어가 나가도 다른 플레이어들의 자리 번호는 바뀌지 않으므로 Players는 항상 자리 순서로 정렬되어 있음
+// 핸드 도중에 앉으면 Players의 인덱스가 밀려서 현재 플레이어와 블라인드가 바뀌므로 핸드가 끝난 후에만 앉을 수 있음
+func (g *Game) AddPlayer(player *Player) error {
+	if g.IsStarted {
+		return gameerror.SeatDuringHand
+	}
+	if g.IsPlayerExist(player.Nickname) {
+		return gameerror.PlayerAlreadyExists
+	}
+
+	for seat := uint(0); seat < g.RoomLimit; seat++ {
+		idx, taken := g.seatIdx(int(seat))
+		if taken {
+			continue
+		}
+
+		g.seatPlayer(player, seat, idx)
+		g.recordSeated(player)
+		return nil
+	}
+	return gameerror.PlayerLimitationError
+}
+
+// seatPlayer는 seatIdx로 구한 인덱스 idx에 플레이어를 끼워넣어 seat에 앉힘
+func (g *Game) seatPlayer(player *Player, seat, idx uint) {
+	player.Seat = seat
+	player.TimeBank = g.TimeBankSize
+	g.Players = append(g.Players, nil)
+	copy(g.Players[idx+1:], g.Players[idx:])
+	g.Players[idx] = player
+}
+
+// seatIdx는 seat에 앉은 플레이어의 인덱스와 앉은 플레이어가 있는지를 리턴함
+// 아무도 없으면 그 자리에 플레이어가 들어갈 인덱스를 리턴함
+func (g *Game) seatIdx(seat int) (uint, bool) {
+	for idx, p := range g.Players {
+		if int(p.Seat) == seat {
+			return uint(idx), true
+		}
+		if int(p.Seat) > seat {
+			return uint(idx), false
+		}
+	}
+	return uint(len(g.Players)), false
+}
+
+// GetButton은 버튼에 앉은 플레이어를 리턴함 (dead button이면 nil)
+func (g *Game) GetButton() *Player {
+	if idx, ok := g.seatIdx(g.ButtonSeat); ok && g.Players[idx].IsReady {
+		return g.Players[idx]
+	}
+	return nil
+}
+
+// setBlinds는 이전 핸드의 버튼과 블라인드 자리를 기준으로 이번 핸드의 버튼, SmallBlind, BigBlind를 정함
+// readyCnt가 2면 헤즈업 규칙을 따라 버튼이 SmallBlind를 냄
+func (g *Game) setBlinds(readyCnt int) {
+	prevSmallBlindSeat, prevBigBlindSeat := g.SmallBlindSeat, g.BigBlindSeat
+	g.IsSmallBlindDead = false
+
+	switch {
+	case g.BigBlindSeat == NoSeat: // 첫 게임
+		g.SmallBlindIdx = getReadyPlayerIdx(g.Players, 0)
+		g.BigBlindIdx = g.nextReadyIdx(g.SmallBlindIdx)
+		if readyCnt == 2 {
+			g.ButtonSeat = int(g.Players[g.SmallBlindIdx].Seat)
+		} else {
+			g.ButtonSeat = int(g.Players[g.prevReadyIdx(g.SmallBlindIdx)].Seat)
+		}
+
+	case readyCnt == 2:
+		// 헤즈업에서는 BigBlind를 연속으로 내지 않도록 BigBlind 기준으로 옮기고 나머지 한명이 버튼이자 SmallBlind가 됨
+		g.BigBlindIdx = g.nextReadyIdxFromSeat(g.BigBlindSeat)
+		g.SmallBlindIdx = g.nextReadyIdx(g.BigBlindIdx)
+		g.ButtonSeat = int(g.Players[g.SmallBlindIdx].Seat)
+
+	case g.ButtonPolicy == DeadButton:
+		g.BigBlindIdx = g.nextReadyIdxFromSeat(g.BigBlindSeat)
+		g.ButtonSeat = g.SmallBlindSeat
+		if idx, ok := g.seatIdx(g.BigBlindSeat); ok && g.Players[idx].IsReady && idx != g.BigBlindIdx {
+			g.SmallBlindIdx = idx
+		} else {
+			g.SmallBlindIdx = g.BigBlindIdx
+			g.IsSmallBlindDead = true
+		}
+
+	default: // MovingButton
+		buttonIdx := g.nextReadyIdxFromSeat(g.ButtonSeat)
+		g.ButtonSeat = int(g.Players[buttonIdx].Seat)
+		g.SmallBlindIdx = g.nextReadyIdx(buttonIdx)
+		g.BigBlindIdx = g.nextReadyIdx(g.SmallBlindIdx)
+	}
+
+	g.BigBlindSeat = int(g.Players[g.BigBlindIdx].Seat)
+	if g.IsSmallBlindDead {
+		// SmallBlind 자리는 비어있어도 다음 핸드의 버튼 위치가 되므로 자리 번호는 남겨둠
+		g.SmallBlindSeat = prevBigBlindSeat
+	} else {
+		g.SmallBlindSeat = int(g.Players[g.SmallBlindIdx].Seat)
+	}
+
+	if prevBigBlindSeat != NoSeat {
+		g.markMissedBlinds(prevSmallBlindSeat, prevBigBlindSeat)
+	}
+}
+
+// markMissedBlinds는 자리를 비운 (준비를 풀었지만 나가지는 않은) 플레이어 중
+// 이번 핸드에서 블라인드가 지나간 플레이어들을 표시함
+// 표시된 플레이어는 다시 게임에 참여할 때 놓친 블라인드를 내야함 (postMissedBlinds 참고)
+func (g *Game) markMissedBlinds(prevSmallBlindSeat, prevBigBlindSeat int) {
+	for _, p := range g.Players {
+		if p.IsReady || p.IsLeft {
+			continue
+		}
+
+		seat := int(p.Seat)
+		if isSeatBetween(seat, prevBigBlindSeat, g.BigBlindSeat) {
+			p.MissedBigBlind = true
+		}
+		if prevSmallBlindSeat != NoSeat && isSeatBetween(seat, prevSmallBlindSeat, g.SmallBlindSeat) {
+			p.MissedSmallBlind = true
+		}
+	}
+}
+
+// from과 to 사이 (둘 다 제외)를 시계 방향으로 돌 때 seat을 지나는지
+func isSeatBetween(seat, from, to int) bool {
+	if from < to {
+		return from < seat && seat < to
+	}
+	return seat > from || seat < to
+}
+
+// idx 다음 자리부터 시계 방향으로 첫 Ready 플레이어의 인덱스
+func (g *Game) nextReadyIdx(idx uint) uint {
+	return getReadyPlayerIdx(g.Players, getNextIdx(g.Players, idx))
+}
+
+// idx 이전 자리부터 반시계 방향으로 첫 Ready 플레이어의 인덱스
+func (g *Game) prevReadyIdx(idx uint) uint {
+	n := uint(len(g.Players))
+	for i := uint(1); i <= n; i++ {
+		prev := (idx + n - i) % n
+		if g.Players[prev].IsReady {
+			return prev
+		}
+	}
+	return idx
+}
+
+// seat 다음 자리부터 시계 방향으로 첫 Ready 플레이어의 인덱스 (seat에 앉은 플레이어가 나갔어도 됨)
+func (g *Game) nextReadyIdxFromSeat(seat int) uint {
+	idx, taken := g.seatIdx(seat + 1)
+	if !taken && idx == uint(len(g.Players)) {
+		idx = 0
+	}
+	return getReadyPlayerIdx(g.Players, idx)
+}
@@ -0,0 +1,240 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/google/uuid"
+)
+
+func TestAddPlayer(t *testing.T) {
+	host := NewPlayer(0, "p0", 1000, 1000)
+	game := NewGame(uuid.New(), 3, host, 10)
+
+	for i := 1; i < 3; i++ {
+		if err := game.AddPlayer(NewPlayer(int64(i), fmt.Sprintf("p%d", i), 1000, 1000)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := game.AddPlayer(NewPlayer(3, "p3", 1000, 1000)); err != gameerror.PlayerLimitationError {
+		t.Errorf("expected %v but got %v", gameerror.PlayerLimitationError, err)
+	}
+	if err := game.AddPlayer(NewPlayer(4, "p1", 1000, 1000)); err != gameerror.PlayerAlreadyExists {
+		t.Errorf("expected %v but got %v", gameerror.PlayerAlreadyExists, err)
+	}
+
+	// 핸드 도중에는 나간 자리가 있어도 앉을 수 없음
+	for _, p := range game.Players {
+		p.IsReady = true
+	}
+	if err := game.StartGame(); err != nil {
+		t.Fatal(err)
+	}
+	current := game.Players[game.CurrentPlayerIdx]
+	game.FindPlayer("p1").IsLeft = true
+	if err := game.AddPlayer(NewPlayer(5, "p5", 1000, 1000)); err != gameerror.SeatDuringHand {
+		t.Errorf("expected %v but got %v", gameerror.SeatDuringHand, err)
+	}
+	if game.Players[game.CurrentPlayerIdx] != current {
+		t.Errorf("current player should stay %s but got %s", current.Nickname, game.Players[game.CurrentPlayerIdx].Nickname)
+	}
+
+	// 나간 자리에 새 플레이어가 앉아도 Players는 자리 순서를 유지해야함
+	game.InitGame()
+	if err := game.AddPlayer(NewPlayer(5, "p5", 1000, 1000)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"p0", "p5", "p2"}
+	for i, p := range game.Players {
+		if p.Nickname != expected[i] || p.Seat != uint(i) {
+			t.Errorf("seat %d: expected %s but got %s (seat %d)", i, expected[i], p.Nickname, p.Seat)
+		}
+	}
+}
+
+func TestSetBlinds(t *testing.T) {
+	type hand struct {
+		leave      []string // 이전 핸드 도중에 나간 플레이어들
+		unready    []string // 이번 핸드 전에 자리를 비운 플레이어들
+		ready      []string // 이번 핸드 전에 다시 돌아온 플레이어들
+		button     string   // 빈 자리에 버튼이 있으면 ""
+		smallBlind string   // dead small blind면 ""
+		bigBlind   string
+		pot        uint64
+	}
+
+	tests := []struct {
+		name   string
+		policy ButtonPolicy
+		hands  []hand
+	}{
+		{
+			name:   "moving button skips the empty seat",
+			policy: MovingButton,
+			hands: []hand{
+				{button: "p3", smallBlind: "p0", bigBlind: "p1", pot: 30},
+				{button: "p0", smallBlind: "p1", bigBlind: "p2", pot: 30},
+				{leave: []string{"p2"}, button: "p1", smallBlind: "p3", bigBlind: "p0", pot: 30},
+				{button: "p3", smallBlind: "p0", bigBlind: "p1", pot: 30},
+			},
+		},
+		{
+			name:   "dead small blind when the last big blind leaves",
+			policy: DeadButton,
+			hands: []hand{
+				{button: "p3", smallBlind: "p0", bigBlind: "p1", pot: 30},
+				{button: "p0", smallBlind: "p1", bigBlind: "p2", pot: 30},
+				{leave: []string{"p2"}, button: "p1", smallBlind: "", bigBlind: "p3", pot: 20},
+				{button: "", smallBlind: "p3", bigBlind: "p0", pot: 30},
+				{button: "p3", smallBlind: "p0", bigBlind: "p1", pot: 30},
+			},
+		},
+		{
+			name:   "dead button when the last small blind leaves",
+			policy: DeadButton,
+			hands: []hand{
+				{button: "p3", smallBlind: "p0", bigBlind: "p1", pot: 30},
+				{leave: []string{"p0"}, button: "", smallBlind: "p1", bigBlind: "p2", pot: 30},
+				{button: "p1", smallBlind: "p2", bigBlind: "p3", pot: 30},
+			},
+		},
+		{
+			name:   "returning player posts missed blinds",
+			policy: MovingButton,
+			hands: []hand{
+				{button: "p3", smallBlind: "p0", bigBlind: "p1", pot: 30},
+				{unready: []string{"p2"}, button: "p0", smallBlind: "p1", bigBlind: "p3", pot: 30},
+				{button: "p1", smallBlind: "p3", bigBlind: "p0", pot: 30},
+				{ready: []string{"p2"}, button: "p2", smallBlind: "p3", bigBlind: "p0", pot: 60}, // BigBlind 20은 라이브, SmallBlind 10은 데드
+			},
+		},
+		{
+			name:   "returning player in the blinds doesn't post twice",
+			policy: MovingButton,
+			hands: []hand{
+				{button: "p3", smallBlind: "p0", bigBlind: "p1", pot: 30},
+				{unready: []string{"p2"}, button: "p0", smallBlind: "p1", bigBlind: "p3", pot: 30},
+				{ready: []string{"p2"}, button: "p1", smallBlind: "p2", bigBlind: "p3", pot: 30},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		host := NewPlayer(0, "p0", 10000, 1000)
+		host.IsReady = true
+		game := NewGame(uuid.New(), 7, host, 10, WithButtonPolicy(tt.policy))
+		for i := 1; i < 4; i++ {
+			p := NewPlayer(int64(i), fmt.Sprintf("p%d", i), 10000, 1000)
+			p.IsReady = true
+			if err := game.AddPlayer(p); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		for i, h := range tt.hands {
+			if i > 0 {
+				for _, nickname := range h.leave {
+					game.FindPlayer(nickname).IsLeft = true
+				}
+				game.InitGame()
+				for _, nickname := range h.unready {
+					game.FindPlayer(nickname).IsReady = false
+				}
+				for _, nickname := range h.ready {
+					game.FindPlayer(nickname).IsReady = true
+				}
+			}
+			if err := game.StartGame(); err != nil {
+				t.Fatalf("%s: hand %d: %s", tt.name, i, err.Error())
+			}
+
+			var button, smallBlind string
+			if p := game.GetButton(); p != nil {
+				button = p.Nickname
+			}
+			if p := game.GetSmallBlind(); p != nil {
+				smallBlind = p.Nickname
+			}
+			bigBlind := game.GetBigBlind().Nickname
+
+			if button != h.button || smallBlind != h.smallBlind || bigBlind != h.bigBlind {
+				t.Errorf("%s: hand %d: expected button/sb/bb %q/%q/%q but got %q/%q/%q",
+					tt.name, i, h.button, h.smallBlind, h.bigBlind, button, smallBlind, bigBlind)
+			}
+			if game.TotalBet != h.pot || game.CurrentBet != 20 {
+				t.Errorf("%s: hand %d: expected pot %d and current bet 20 but got %d and %d",
+					tt.name, i, h.pot, game.TotalBet, game.CurrentBet)
+			}
+		}
+	}
+}
+
+func TestReturningPlayerCanCheckLiveBlind(t *testing.T) {
+	host := NewPlayer(0, "p0", 10000, 1000)
+	host.IsReady = true
+	game := NewGame(uuid.New(), 7, host, 10)
+	for i := 1; i < 4; i++ {
+		p := NewPlayer(int64(i), fmt.Sprintf("p%d", i), 10000, 1000)
+		p.IsReady = i != 2
+		if err := game.AddPlayer(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// p2가 자리를 비운 동안 BigBlind와 SmallBlind가 모두 지나감
+	for i := 0; i < 3; i++ {
+		if err := game.StartGame(); err != nil {
+			t.Fatal(err)
+		}
+		game.InitGame()
+	}
+
+	returning := game.FindPlayer("p2")
+	if !returning.MissedSmallBlind || !returning.MissedBigBlind {
+		t.Fatal("p2 should have missed both blinds")
+	}
+
+	returning.IsReady = true
+	if err := game.StartGame(); err != nil {
+		t.Fatal(err)
+	}
+	if returning.CurrentBet != 20 || returning.TotalBet != 30 || returning.MissedSmallBlind || returning.MissedBigBlind {
+		t.Errorf("p2 should post 20 live and 10 dead but got current bet %d and total bet %d", returning.CurrentBet, returning.TotalBet)
+	}
+
+	// p1 콜 후 p2는 이미 BigBlind만큼 냈으므로 체크할 수 있음
+	if _, err := game.HandleAction("p1", Action{Type: Call}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := game.HandleAction("p2", Action{Type: Check}); err != nil {
+		t.Errorf("returning player should be able to check but got %v", err)
+	}
+}
+
+func TestBustedPlayerSitsOut(t *testing.T) {
+	game := newActionGame(1000, 1000, 1000)
+	game.Players[1].GameBalance = 0
+
+	game.InitGame()
+	if game.Players[1].IsReady {
+		t.Error("player without balance should not be dealt in next hand")
+	}
+
+	if err := game.SetReady("p1", true); err != gameerror.EmptyGameBalance {
+		t.Errorf("player without balance should not be able to ready up again but got %v", err)
+	}
+
+	// 준비 상태가 남아있어도 카드를 받지 않고 팟을 가져갈 수 없음
+	game.Players[1].IsReady = true
+	if err := game.StartGame(); err != nil {
+		t.Fatal(err)
+	}
+	if p := game.Players[1]; p.IsReady || p.Hands != nil || p.TotalBet != 0 {
+		t.Errorf("player without balance should sit out but got ready %v with %d cards and %d bet", p.IsReady, len(p.Hands), p.TotalBet)
+	}
+	if len(game.GetReadyPlayers()) != 2 {
+		t.Errorf("only players with balance should be ready but got %d", len(game.GetReadyPlayers()))
+	}
+}
@@ -0,0 +1,453 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/google/uuid"
+)
+
+// EventType은 게임에서 일어난 상태 변화의 종류
+//
+// 명령 이벤트는 플레이어나 서버의 요청으로 생기고 결과 이벤트는 명령을 처리하면서 함께 생김
+// 어느 쪽이든 이미 검증을 통과한 결과만 담고 있으므로 ApplyEvent는 규칙을 다시 검사하지 않고 그대로 게임에 반영함
+type EventType string
+
+const (
+	// 명령 이벤트
+	GameCreated     EventType = "GameCreated"     // 방이 만들어짐 (Config, 첫 핸드의 ServerSeed)
+	PlayerSeated    EventType = "PlayerSeated"    // 플레이어가 자리에 앉음
+	PlayerReady     EventType = "PlayerReady"     // 플레이어가 준비하거나 준비를 풀음
+	AutoMuckChanged EventType = "AutoMuckChanged" // 플레이어가 AutoMuck 설정을 바꿈
+	ClientSeedAdded EventType = "ClientSeedAdded" // 플레이어가 다음 핸드의 시드를 보냄
+	HandStarted     EventType = "HandStarted"     // 핸드가 시작됨 (Seat은 버튼 자리, Cards는 섞인 덱 전체)
+	PlayerActed     EventType = "PlayerActed"     // 플레이어가 액션함 (시간이 지나서 자동으로 한 액션 포함)
+	TimeBankUsed    EventType = "TimeBankUsed"    // 플레이어가 타임뱅크를 씀
+	ShowdownDecided EventType = "ShowdownDecided" // 쇼다운에서 핸드를 버릴 수 있는 플레이어가 공개하거나 버림 (시간이 지나서 버린 경우 포함)
+	PlayerLeft      EventType = "PlayerLeft"      // 플레이어가 방에서 나감 (핸드 도중이면 폴드한 것으로 봄)
+	HandReset       EventType = "HandReset"       // 다음 핸드를 위해 초기화됨 (다음 핸드의 ServerSeed)
+
+	// 결과 이벤트
+	BlindPosted EventType = "BlindPosted" // 앤티나 블라인드를 냄
+	CardsDealt  EventType = "CardsDealt"  // 플레이어가 홀카드를 받음
+	StreetDealt EventType = "StreetDealt" // 플랍, 턴, 리버가 깔림 (Cards는 새로 깔린 카드)
+	PotAwarded  EventType = "PotAwarded"  // 팟이 승자들에게 나눠짐
+)
+
+// BlindType은 BlindPosted 이벤트에서 어떤 금액을 냈는지
+type BlindType string
+
+const (
+	PostAnte             BlindType = "Ante"
+	PostSmallBlind       BlindType = "SmallBlind"
+	PostBigBlind         BlindType = "BigBlind"
+	PostMissedSmallBlind BlindType = "MissedSmallBlind" // 데드로 팟에만 들어감
+	PostMissedBigBlind   BlindType = "MissedBigBlind"
+)
+
+// Event는 한번 기록되면 바뀌지 않는 게임의 상태 변화
+// 이벤트 종류마다 필요한 필드만 채워짐
+// ServerSeed와 모든 플레이어의 홀카드가 들어있으므로 서버 밖으로 그대로 보내면 안됨
+type Event struct {
+	Seq  uint64    `json:"seq"` // 방마다 1부터 1씩 증가함
+	Type EventType `json:"type"`
+	Time time.Time `json:"time"`
+
+	Nickname     string           `json:"nickname,omitempty"`
+	Config       *GameConfig      `json:"config,omitempty"`    // GameCreated
+	PlayerId     int64            `json:"player_id,omitempty"` // PlayerSeated
+	Seat         int              `json:"seat,omitempty"`      // PlayerSeated, HandStarted
+	TotalBalance uint64           `json:"total_balance,omitempty"`
+	GameBalance  uint64           `json:"game_balance,omitempty"`
+	IsReady      bool             `json:"is_ready,omitempty"`  // PlayerReady
+	AutoMuck     bool             `json:"auto_muck,omitempty"` // AutoMuckChanged
+	Seed         string           `json:"seed,omitempty"`      // GameCreated, HandReset의 ServerSeed 또는 ClientSeedAdded의 시드
+	Action       *Action          `json:"action,omitempty"`    // PlayerActed (실제로 처리된 액션)
+	TimeBank     time.Duration    `json:"time_bank,omitempty"` // TimeBankUsed에서 실제로 쓴 시간
+	Blind        BlindType        `json:"blind,omitempty"`     // BlindPosted
+	Amount       uint64           `json:"amount,omitempty"`    // BlindPosted, PotAwarded
+	Cards        []card.Card      `json:"cards,omitempty"`     // HandStarted, CardsDealt, StreetDealt
+	Phase        Phase            `json:"phase,omitempty"`     // StreetDealt
+	Decision     ShowdownDecision `json:"decision,omitempty"`  // ShowdownDecided
+	Winners      []string         `json:"winners,omitempty"`   // PotAwarded
+}
+
+// GameConfig는 방을 만들 때 정해져서 바뀌지 않는 설정
+type GameConfig struct {
+	RoomId        uuid.UUID     `json:"room_id"`
+	RoomLimit     uint          `json:"room_limit"`
+	MinBetAmount  uint64        `json:"min_bet_amount"`
+	Ante          uint64        `json:"ante"`
+	Variant       card.Variant  `json:"variant"`
+	ButtonPolicy  ButtonPolicy  `json:"button_policy"`
+	ActionTimeout time.Duration `json:"action_timeout"`
+	TimeBankSize  time.Duration `json:"time_bank_size"`
+	RunoutDelay   time.Duration `json:"runout_delay"`
+}
+
+func (g *Game) config() *GameConfig {
+	return &GameConfig{
+		RoomId:        g.RoomId,
+		RoomLimit:     g.RoomLimit,
+		MinBetAmount:  g.MinBetAmount,
+		Ante:          g.Ante,
+		Variant:       g.Variant,
+		ButtonPolicy:  g.ButtonPolicy,
+		ActionTimeout: g.ActionTimeout,
+		TimeBankSize:  g.TimeBankSize,
+		RunoutDelay:   g.RunoutDelay,
+	}
+}
+
+func (c *GameConfig) options() []GameOption {
+	return []GameOption{
+		WithAnte(c.Ante),
+		WithVariant(c.Variant),
+		WithButtonPolicy(c.ButtonPolicy),
+		WithActionTimeout(c.ActionTimeout),
+		WithTimeBank(c.TimeBankSize),
+		WithRunoutDelay(c.RunoutDelay),
+	}
+}
+
+// record는 이벤트에 순서와 시간을 붙여서 아직 저장하지 않은 이벤트들에 추가함
+func (g *Game) record(e Event) {
+	g.Version++
+	e.Seq = g.Version
+	e.Time = g.now()
+	g.pending = append(g.pending, e)
+}
+
+func (g *Game) recordSeated(p *Player) {
+	g.record(Event{
+		Type:         PlayerSeated,
+		Nickname:     p.Nickname,
+		PlayerId:     p.Id,
+		Seat:         int(p.Seat),
+		TotalBalance: p.TotalBalance,
+		GameBalance:  p.GameBalance,
+	})
+}
+
+// PendingEvents는 아직 저장하지 않은 이벤트들을 비우지 않고 리턴함
+func (g *Game) PendingEvents() []Event {
+	return g.pending
+}
+
+// TakeEvents는 아직 저장하지 않은 이벤트들을 꺼내고 비움
+// 게임을 저장할 때 이 이벤트들을 먼저 이벤트 로그에 추가해야함
+func (g *Game) TakeEvents() []Event {
+	events := g.pending
+	g.pending = nil
+	return events
+}
+
+// SetReady는 핸드 시작 전에 플레이어의 준비 상태를 바꿈
+func (g *Game) SetReady(nickname string, isReady bool) error {
+	if g.IsStarted {
+		return gameerror.GameAlreadyStarted
+	}
+	p := g.FindPlayer(nickname)
+	if p == nil {
+		return gameerror.NoPlayerExists
+	}
+	// 돈을 모두 잃은 플레이어는 다음 핸드에 카드를 받을 수 없음
+	if isReady && p.GameBalance == 0 {
+		return gameerror.EmptyGameBalance
+	}
+
+	p.IsReady = isReady
+	g.record(Event{Type: PlayerReady, Nickname: nickname, IsReady: isReady})
+	return nil
+}
+
+// SetAutoMuck은 쇼다운에서 이길 수 없는 핸드를 자동으로 버릴지 설정함
+func (g *Game) SetAutoMuck(nickname string, autoMuck bool) error {
+	p := g.FindPlayer(nickname)
+	if p == nil {
+		return gameerror.NoPlayerExists
+	}
+
+	p.AutoMuck = autoMuck
+	g.record(Event{Type: AutoMuckChanged, Nickname: nickname, AutoMuck: autoMuck})
+	return nil
+}
+
+// ReplayGame은 이벤트 로그를 처음부터 순서대로 ApplyEvent로 쌓아서 게임을 다시 만듦
+// 핸드가 시작될 때의 덱이 HandStarted에 들어있으므로 WithShuffler로 만든 게임도 같은 카드가 나옴
+// (opts는 아직 시작하지 않은 핸드의 덱을 만들 때만 쓰임)
+func ReplayGame(events []Event, opts ...GameOption) (*Game, error) {
+	if len(events) < 2 || events[0].Type != GameCreated || events[0].Config == nil || events[1].Type != PlayerSeated ||
+		events[0].Seq != 1 || events[1].Seq != 2 {
+		return nil, gameerror.InvalidEventLog
+	}
+	created, seated := events[0], events[1]
+
+	// NewGame이 만드는 상태는 GameCreated와 방장의 PlayerSeated를 반영한 것과 같으므로 새로 기록된 이벤트는 버림
+	host := NewPlayer(seated.PlayerId, seated.Nickname, seated.TotalBalance, seated.GameBalance)
+	opts = append(append(created.Config.options(), opts...), withNextSeed(created.Seed))
+	game := NewGame(created.Config.RoomId, created.Config.RoomLimit, host, created.Config.MinBetAmount, opts...)
+	game.TakeEvents()
+
+	if err := game.ApplyEvents(events[2:]); err != nil {
+		return nil, err
+	}
+	return game, nil
+}
+
+// ApplyEvents는 저장된 게임 이후에 기록된 이벤트들을 순서대로 반영함
+// Version 이하의 이벤트는 이미 반영된 것이므로 건너뜀
+func (g *Game) ApplyEvents(events []Event) error {
+	for _, e := range events {
+		if e.Seq <= g.Version {
+			continue
+		}
+		if err := g.ApplyEvent(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyEvent는 이벤트 하나를 게임에 반영하고 Version을 e.Seq로 올림
+// e.Seq가 Version 바로 다음이 아니면 InvalidEventLog를 리턴하고
+// 이벤트가 게임 상태와 맞지 않으면 (없는 플레이어, 덱에 없는 카드 등) EventLogMismatch를 리턴함
+// 마감 시간은 이벤트가 기록된 시간을 기준으로 다시 계산됨
+func (g *Game) ApplyEvent(e Event) error {
+	if e.Seq != g.Version+1 {
+		return gameerror.InvalidEventLog
+	}
+
+	defer g.freezeClockAt(e.Time)()
+
+	if err := g.applyEvent(e); err != nil {
+		return err
+	}
+	g.Version = e.Seq
+	return nil
+}
+
+func (g *Game) applyEvent(e Event) error {
+	if e.Type == PlayerSeated {
+		idx, taken := g.seatIdx(e.Seat)
+		if g.IsStarted || g.IsPlayerExist(e.Nickname) || taken || e.Seat < 0 || uint(e.Seat) >= g.RoomLimit {
+			return gameerror.EventLogMismatch
+		}
+		g.seatPlayer(NewPlayer(e.PlayerId, e.Nickname, e.TotalBalance, e.GameBalance), uint(e.Seat), idx)
+		return nil
+	}
+
+	// 플레이어에 대한 이벤트는 그 플레이어가 앉아있어야함
+	var p *Player
+	switch e.Type {
+	case PlayerReady, AutoMuckChanged, ClientSeedAdded, BlindPosted, CardsDealt, PlayerActed, TimeBankUsed, ShowdownDecided, PlayerLeft:
+		if p = g.FindPlayer(e.Nickname); p == nil {
+			return gameerror.EventLogMismatch
+		}
+	}
+
+	switch e.Type {
+	case PlayerReady:
+		p.IsReady = e.IsReady
+	case AutoMuckChanged:
+		p.AutoMuck = e.AutoMuck
+	case ClientSeedAdded:
+		g.setClientSeed(p.Nickname, e.Seed)
+	case HandStarted:
+		return g.applyHandStarted(e)
+	case BlindPosted:
+		if e.Amount > p.Stack() {
+			return gameerror.EventLogMismatch
+		}
+		g.applyBlind(p, e.Blind, e.Amount)
+		// 블라인드로 올인된 플레이어는 액션할 수 없으므로 StartGame처럼 다음 플레이어에게 첫 액션을 넘기고 타이머를 다시 시작함
+		// (StartGame은 블라인드를 모두 낸 후에 한번만 정하지만 올인된 플레이어는 계속 액션할 수 없으므로 결과가 같음)
+		if !p.canAct() {
+			g.setFirstToAct()
+			g.startActionClock()
+		}
+	case CardsDealt:
+		for _, c := range e.Cards {
+			if !removeCard(g.Deck, c) {
+				return gameerror.EventLogMismatch
+			}
+		}
+		p.Hands = append([]card.Card(nil), e.Cards...)
+	case PlayerActed:
+		if e.Action == nil || !g.IsStarted || !g.Phase.IsBetting() || g.Players[g.CurrentPlayerIdx] != p {
+			return gameerror.EventLogMismatch
+		}
+		return g.applyRoundEnd(g.act(p, *e.Action))
+	case PlayerLeft:
+		if g.Phase == PhaseShowdown {
+			g.leave(p)
+			return nil
+		}
+		if result := g.leave(p); result != nil {
+			return g.applyRoundEnd(result)
+		}
+	case ShowdownDecided:
+		if g.Phase != PhaseShowdown || g.Players[g.CurrentPlayerIdx] != p || (e.Decision != Show && e.Decision != Muck) {
+			return gameerror.EventLogMismatch
+		}
+		g.decide(p, e.Decision)
+	case TimeBankUsed:
+		if g.ActionDeadline.IsZero() || e.TimeBank > p.TimeBank {
+			return gameerror.EventLogMismatch
+		}
+		g.useTimeBank(p, e.TimeBank)
+	case StreetDealt:
+		return g.applyStreetDealt(e)
+	case PotAwarded:
+		return g.applyPotAwarded(e)
+	case HandReset:
+		g.nextSeed = e.Seed
+		g.resetHand()
+	default:
+		// GameCreated는 로그의 첫 이벤트로만 올 수 있음
+		return gameerror.InvalidEventLog
+	}
+	return nil
+}
+
+// applyRoundEnd는 리버의 베팅이 끝났으면 Apply처럼 쇼다운을 시작함 (정산은 뒤따르는 PotAwarded로 반영됨)
+// 다른 스트릿으로 넘어가는 것은 뒤따르는 StreetDealt로 반영됨
+func (g *Game) applyRoundEnd(result *ActionResult) error {
+	if result.IsRoundEnd && !result.IsHandOver && g.Phase == PhaseRiver {
+		if _, err := g.startShowdown(); err != nil {
+			return gameerror.EventLogMismatch
+		}
+	}
+	return nil
+}
+
+// applyHandStarted는 StartGame처럼 버튼과 블라인드를 정하고 기록된 덱으로 프리플랍을 시작함
+// 카드와 블라인드는 뒤따르는 CardsDealt와 BlindPosted로 반영됨
+func (g *Game) applyHandStarted(e Event) error {
+	if !g.Phase.CanTransition(PhaseBlinds) {
+		return gameerror.EventLogMismatch
+	}
+	if _, err := g.setPlayers(); err != nil || g.ButtonSeat != e.Seat {
+		return gameerror.EventLogMismatch
+	}
+	g.Phase = PhaseBlinds
+
+	deck := card.Deck(append([]card.Card(nil), e.Cards...))
+	g.Deck = &deck
+
+	// postBlinds에서 놓친 블라인드를 걷은 플레이어들과 BigBlind 금액은 이벤트에 남지 않으므로 여기서 맞춰줌
+	for _, p := range g.Players {
+		if p.IsReady {
+			p.MissedSmallBlind = false
+			p.MissedBigBlind = false
+		}
+	}
+	g.CurrentBet = g.BigBlindAmount()
+	return g.startPreflop()
+}
+
+// applyStreetDealt는 기록된 덱에서 DealNextStreet처럼 한장을 버리고 카드를 깔아서 기록된 카드와 같은지 확인함
+func (g *Game) applyStreetDealt(e Event) error {
+	if !g.Phase.IsBetting() || !g.Phase.CanTransition(e.Phase) {
+		return gameerror.EventLogMismatch
+	}
+
+	// 런아웃이면 베팅 라운드를 초기화하지 않고 남은 스트릿을 이어서 깔음 (Game.runOut 참고)
+	isRunout := g.IsRunout()
+	boardLen := len(g.Board)
+	if err := g.dealStreet(e.Phase); err != nil || !sameCards(g.Board[boardLen:], e.Cards) {
+		return gameerror.EventLogMismatch
+	}
+	g.Phase = e.Phase
+
+	if !isRunout {
+		g.ResetBettingRound()
+		return nil
+	}
+	g.stopActionClock()
+	if g.Phase == PhaseRiver {
+		if _, err := g.startShowdown(); err != nil {
+			return gameerror.EventLogMismatch
+		}
+	}
+	return nil
+}
+
+// applyPotAwarded는 팟 하나를 승자들에게 나눠줌
+// 핸드의 첫 PotAwarded에서 completeHand처럼 핸드를 끝내고 모든 플레이어의 베팅액을 잔고에서 빼둠
+// 나누어 떨어지지 않는 칩은 AwardPots처럼 Winners의 앞에서부터 한개씩 줌
+func (g *Game) applyPotAwarded(e Event) error {
+	if g.Phase != PhaseHandComplete {
+		if err := g.endHand(); err != nil {
+			return err
+		}
+	}
+
+	if len(e.Winners) == 0 {
+		return nil
+	}
+	share := e.Amount / uint64(len(e.Winners))
+	oddChips := e.Amount % uint64(len(e.Winners))
+	for i, nickname := range e.Winners {
+		p := g.FindPlayer(nickname)
+		if p == nil {
+			return gameerror.EventLogMismatch
+		}
+		won := share
+		if uint64(i) < oddChips {
+			won++
+		}
+		p.GameBalance += won
+		p.TotalBalance += won
+	}
+	return nil
+}
+
+// endHand는 폴드나 쇼다운으로 끝난 핸드를 completeHand처럼 끝냄
+func (g *Game) endHand() error {
+	if err := g.transition(PhaseHandComplete); err != nil {
+		return gameerror.EventLogMismatch
+	}
+
+	for _, p := range g.Players {
+		p.GameBalance -= p.TotalBet
+		p.TotalBalance -= p.TotalBet
+	}
+	g.IsStarted = false
+	g.stopActionClock()
+	return nil
+}
+
+// removeCard는 덱에서 c를 빼고 나머지 카드들의 순서는 그대로 둠
+func removeCard(deck *card.Deck, c card.Card) bool {
+	if deck == nil {
+		return false
+	}
+	for i := range *deck {
+		if (*deck)[i] == c {
+			*deck = append((*deck)[:i], (*deck)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func sameCards(a, b []card.Card) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// withNextSeed는 NewGame에서 새로 만들 ServerSeed 대신 seed를 사용하게 함 (ReplayGame용)
+func withNextSeed(seed string) GameOption {
+	return func(g *Game) {
+		g.nextSeed = seed
+	}
+}
@@ -0,0 +1,238 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/google/uuid"
+)
+
+// 두 핸드를 진행하면서 생긴 이벤트들과 명령을 하나 처리할 때마다 찍은 게임의 JSON을 Version별로 리턴함
+// 첫 핸드는 쇼다운까지 체크하고 AutoMuck을 끈 p1은 핸드를 공개함 (p1이 결정해야하도록 첫 ServerSeed를 고정함)
+// 두번째 핸드는 첫 플레이어가 레이즈한 후 모두 폴드함
+func playEventGame(t *testing.T) (*Game, []Event, map[uint64]string) {
+	// 명령을 처리하는 동안에도 시간이 흐르므로 이벤트에 기록된 시간과 마감 시간이 어긋나지 않는지도 확인함
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), tick: time.Millisecond}
+	game := NewGame(uuid.New(), 7, NewPlayer(0, "p0", 10000, 1000), 10, WithClock(clock.Now), WithAnte(5), withNextSeed(strings.Repeat("0", 64)))
+
+	snapshots := make(map[uint64]string)
+	do := func(err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := game.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		snapshots[game.Version] = string(data)
+	}
+	apply := func(nickname string, action Action) {
+		clock.now = clock.now.Add(time.Second)
+		_, err := game.Apply(nickname, action)
+		do(err)
+	}
+
+	do(nil)
+	for i := 1; i < 3; i++ {
+		do(game.AddPlayer(NewPlayer(int64(i), fmt.Sprintf("p%d", i), 10000, 1000)))
+	}
+	for _, p := range game.Players {
+		do(game.SetReady(p.Nickname, true))
+	}
+	do(game.SetAutoMuck("p1", false))
+	do(game.AddClientSeed("p2", "lucky"))
+	do(game.StartGame())
+
+	clock.now = clock.now.Add(3 * time.Second)
+	_, err := game.ExtendTimeBank("p2", 5*time.Second)
+	do(err)
+	for game.Phase.IsBetting() || game.Phase == PhaseShowdown {
+		p := game.Players[game.CurrentPlayerIdx]
+		if game.Phase == PhaseShowdown {
+			clock.now = clock.now.Add(time.Second)
+			_, err := game.Decide(p.Nickname, Show)
+			do(err)
+		} else if p.CurrentBet < game.CurrentBet {
+			apply(p.Nickname, Action{Type: Call})
+		} else {
+			apply(p.Nickname, Action{Type: Check})
+		}
+	}
+	if game.Phase != PhaseHandComplete {
+		t.Fatalf("first hand should be complete but got %s", game.Phase)
+	}
+	game.InitGame()
+	do(nil)
+
+	do(game.StartGame())
+	apply(game.Players[game.CurrentPlayerIdx].Nickname, Action{Type: Raise, Amount: 60})
+	for game.Phase != PhaseHandComplete {
+		apply(game.Players[game.CurrentPlayerIdx].Nickname, Action{Type: Fold})
+	}
+	game.InitGame()
+	do(nil)
+
+	return game, game.TakeEvents(), snapshots
+}
+
+// 이벤트 로그에 저장했다가 다시 읽어온 것처럼 JSON으로 바꿨다가 되돌림
+func storeEvents(t *testing.T, events []Event) []Event {
+	data, err := json.Marshal(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stored []Event
+	if err := json.Unmarshal(data, &stored); err != nil {
+		t.Fatal(err)
+	}
+	return stored
+}
+
+func TestEventLog(t *testing.T) {
+	game, events, _ := playEventGame(t)
+
+	if game.Version != uint64(len(events)) {
+		t.Errorf("version should be %d but got %d", len(events), game.Version)
+	}
+	count := make(map[EventType]int)
+	for i, e := range events {
+		if e.Seq != uint64(i+1) {
+			t.Errorf("event #%d should have seq %d but got %d", i, i+1, e.Seq)
+		}
+		count[e.Type]++
+	}
+
+	tests := []struct {
+		eventType EventType
+		count     int
+	}{
+		{GameCreated, 1},
+		{PlayerSeated, 3},
+		{PlayerReady, 3},
+		{AutoMuckChanged, 1},
+		{ClientSeedAdded, 1},
+		{HandStarted, 2},
+		{BlindPosted, 10}, // 핸드마다 앤티 3번과 블라인드 2번
+		{CardsDealt, 6},
+		{TimeBankUsed, 1},
+		{PlayerActed, 15},
+		{ShowdownDecided, 1},
+		{StreetDealt, 3},
+		{PotAwarded, 2},
+		{HandReset, 2},
+	}
+	for _, tt := range tests {
+		if count[tt.eventType] != tt.count {
+			t.Errorf("%s should be recorded %d times but got %d", tt.eventType, tt.count, count[tt.eventType])
+		}
+	}
+
+	if len(game.TakeEvents()) != 0 {
+		t.Error("TakeEvents should clear pending events")
+	}
+}
+
+func TestReplayGame(t *testing.T) {
+	_, events, snapshots := playEventGame(t)
+	stored := storeEvents(t, events)
+
+	// 명령을 하나 처리할 때마다 찍어둔 게임과 그때까지의 이벤트를 쌓아서 만든 게임이 같아야함
+	for version, snapshot := range snapshots {
+		replayed, err := ReplayGame(stored[:version])
+		if err != nil {
+			t.Fatalf("version %d: %s", version, err.Error())
+		}
+		data, err := replayed.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != snapshot {
+			t.Errorf("version %d: replayed game should be\n%s\nbut got\n%s", version, snapshot, data)
+		}
+	}
+}
+
+func TestApplyEvents(t *testing.T) {
+	game, events, snapshots := playEventGame(t)
+	stored := storeEvents(t, events)
+	expected, err := game.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 어느 시점에 저장된 게임이든 그 이후의 이벤트를 반영하면 마지막 게임과 같아야함
+	// 이미 반영된 이벤트들은 건너뛰므로 로그 전체를 넘겨도 됨
+	for version, snapshot := range snapshots {
+		var saved Game
+		if err := saved.UnmarshalBinary([]byte(snapshot)); err != nil {
+			t.Fatal(err)
+		}
+		if err := saved.ApplyEvents(stored); err != nil {
+			t.Fatalf("version %d: %s", version, err.Error())
+		}
+		data, err := saved.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != string(expected) {
+			t.Errorf("version %d: game should catch up to\n%s\nbut got\n%s", version, expected, data)
+		}
+	}
+
+	var saved Game
+	if err := saved.UnmarshalBinary([]byte(snapshots[2])); err != nil {
+		t.Fatal(err)
+	}
+	if err := saved.ApplyEvents(stored[3:]); err != gameerror.InvalidEventLog {
+		t.Errorf("missing events should return %v but got %v", gameerror.InvalidEventLog, err)
+	}
+}
+
+func TestReplayGameWithInvalidLog(t *testing.T) {
+	tamper := func(match EventType, change func(e *Event)) func([]Event) []Event {
+		return func(events []Event) []Event {
+			for i := range events {
+				if events[i].Type == match {
+					change(&events[i])
+					break
+				}
+			}
+			return events
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func([]Event) []Event
+		err    error
+	}{
+		{"empty log", func(events []Event) []Event { return nil }, gameerror.InvalidEventLog},
+		{"missing GameCreated", func(events []Event) []Event { return events[1:] }, gameerror.InvalidEventLog},
+		{"missing event", func(events []Event) []Event {
+			for i := range events {
+				if events[i].Type == StreetDealt {
+					return append(events[:i:i], events[i+1:]...)
+				}
+			}
+			return events
+		}, gameerror.InvalidEventLog},
+		{"unknown player", tamper(PlayerReady, func(e *Event) { e.Nickname = "nobody" }), gameerror.EventLogMismatch},
+		{"different button", tamper(HandStarted, func(e *Event) { e.Seat++ }), gameerror.EventLogMismatch},
+		{"card dealt twice", tamper(CardsDealt, func(e *Event) { e.Cards[1] = e.Cards[0] }), gameerror.EventLogMismatch},
+		{"different board", tamper(StreetDealt, func(e *Event) { e.Cards[0], e.Cards[1] = e.Cards[1], e.Cards[0] }), gameerror.EventLogMismatch},
+		{"blind over stack", tamper(BlindPosted, func(e *Event) { e.Amount = 1000000 }), gameerror.EventLogMismatch},
+		{"action out of turn", tamper(PlayerActed, func(e *Event) { e.Nickname = "p0" }), gameerror.EventLogMismatch},
+		{"decision out of turn", tamper(ShowdownDecided, func(e *Event) { e.Nickname = "p2" }), gameerror.EventLogMismatch},
+	}
+
+	for _, tt := range tests {
+		_, events, _ := playEventGame(t)
+		if _, err := ReplayGame(tt.modify(events)); err != tt.err {
+			t.Errorf("%s: should return %v but got %v", tt.name, tt.err, err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+)
+
+const maxClientSeedLength = 64
+
+type ClientSeed struct {
+	Nickname string `json:"nickname"`
+	Seed     string `json:"seed"`
+}
+
+// FairnessProof는 핸드가 끝난 후 공개되어 덱 순서를 검증하는데 사용됨
+// card.VerifyDeck(ServerSeed, SeedCommitment, ClientSeeds, 덱, card.WithVariant(Variant))으로 확인할 수 있음
+type FairnessProof struct {
+	ServerSeed     string       `json:"server_seed"`
+	SeedCommitment string       `json:"seed_commitment"`
+	ClientSeeds    []string     `json:"client_seeds"`
+	Variant        card.Variant `json:"variant"` // 0: Standard, 1: ShortDeck
+}
+
+// 새 핸드를 위한 ServerSeed를 만들고 commitment를 갱신함
+func (g *Game) newSeed() {
+	// 이벤트 로그를 다시 적용할 때는 기록된 시드를 사용함
+	if g.nextSeed != "" {
+		g.ServerSeed, g.nextSeed = g.nextSeed, ""
+	} else {
+		g.ServerSeed = card.NewServerSeed()
+	}
+	g.SeedCommitment = card.CommitSeed(g.ServerSeed)
+	g.ClientSeeds = nil
+}
+
+// AddClientSeed는 핸드 시작 전에 플레이어가 보낸 시드를 저장함
+// 같은 플레이어가 다시 보내면 이전 시드를 덮어씀
+func (g *Game) AddClientSeed(nickname, seed string) error {
+	if g.IsStarted {
+		return gameerror.GameAlreadyStarted
+	}
+	if !g.IsPlayerExist(nickname) {
+		return gameerror.NoPlayerExists
+	}
+	if seed == "" || len(seed) > maxClientSeedLength || strings.Contains(seed, ",") {
+		return gameerror.InvalidClientSeed
+	}
+
+	g.record(Event{Type: ClientSeedAdded, Nickname: nickname, Seed: seed})
+	g.setClientSeed(nickname, seed)
+	return nil
+}
+
+func (g *Game) setClientSeed(nickname, seed string) {
+	for i := range g.ClientSeeds {
+		if g.ClientSeeds[i].Nickname == nickname {
+			g.ClientSeeds[i].Seed = seed
+			return
+		}
+	}
+	g.ClientSeeds = append(g.ClientSeeds, ClientSeed{Nickname: nickname, Seed: seed})
+}
+
+// GetFairnessProof는 현재 핸드의 ServerSeed를 공개함
+// 핸드가 끝난 후 InitGame으로 새 시드를 만들기 전에 호출해야함
+func (g *Game) GetFairnessProof() *FairnessProof {
+	return &FairnessProof{
+		ServerSeed:     g.ServerSeed,
+		SeedCommitment: g.SeedCommitment,
+		ClientSeeds:    g.clientSeedValues(),
+		Variant:        g.Variant,
+	}
+}
+
+func (g *Game) clientSeedValues() []string {
+	seeds := make([]string, len(g.ClientSeeds))
+	for i, s := range g.ClientSeeds {
+		seeds[i] = s.Seed
+	}
+	return seeds
+}
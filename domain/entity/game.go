@@ -2,50 +2,100 @@ package entity
 
 import (
 	"encoding/json"
-	"errors"
-	"sort"
+	"time"
 
 	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/carderror"
 	"github.com/PudgeKim/go-holdem/errors/gameerror"
-	"github.com/PudgeKim/go-holdem/gameconst"
 	"github.com/google/uuid"
 )
 
-type CardCompareResult int
-
-const (
-	Player1Win CardCompareResult = iota
-	Player2Win
-	Draw
-)
-
 type Game struct {
-	Memento *GameMemento
+	Memento *GameMemento `json:"-"` // 요청을 처리하는 동안에만 쓰므로 저장하지 않음
 
 	RoomId uuid.UUID
 	RoomLimit uint 
 	HostName string 
 	Players    []*Player // 게임에 참가하고 있는 플레이어들
 	MinBetAmount uint64 // SmallBlind가 걸어야할 최소 금액 
+	Ante         uint64 // 핸드 시작 시 모든 플레이어가 내는 금액 (0이면 앤티 없음)
 	TotalBet   uint64           // 해당 게임에서 모든 플레이어들의 베팅액 합산 (새로운 게임이 시작되면 초기화됨)
 	CurrentBet uint64           // 현재 턴에서 최고 베팅액 (player1이 20을 걸었고 player2가 30을 걸었으면 currentBet을 30으로 변경해줘야함)
 	IsStarted  bool             // 게임이 시작됬는지
+	MinRaise   uint64           // 이번 스트릿에서 레이즈할 때 최소로 올려야하는 금액 (마지막 레이즈 크기, 처음엔 BigBlind 금액)
 
 	Deck            *card.Deck
-	Status          string                    // FreeFlop인지 Turn인지 등
+	Board           []card.Card               // 지금까지 깔린 커뮤니티 카드 (플랍 3장, 턴 1장, 리버 1장)
+	Variant         card.Variant              // 일반 덱인지 숏덱인지 (방을 만들 때 정해짐)
+	Phase           Phase                     // 핸드가 어느 단계인지 (phase.go 참고)
+
+	// 공정한 셔플을 위한 값들 (fairness.go 참고)
+	ServerSeed     string       // 핸드가 끝나기 전까지 클라이언트에게 절대 공개하면 안됨
+	SeedCommitment string       // ServerSeed의 해시로 핸드 시작 전에 공개됨
+	ClientSeeds    []ClientSeed // 핸드 시작 전까지 플레이어들이 보낸 시드
 
 	SmallBlindIdx uint
 	BigBlindIdx   uint
 
+	// 자리 번호는 플레이어가 나가도 바뀌지 않으므로 다음 핸드의 버튼과 블라인드를 정할 때 사용함 (button.go 참고)
+	ButtonPolicy     ButtonPolicy
+	ButtonSeat       int  // 버튼이 놓인 자리 (DeadButton이면 빈 자리일 수도 있음)
+	SmallBlindSeat   int
+	BigBlindSeat     int
+	IsSmallBlindDead bool // SmallBlind 자리의 플레이어가 나가서 이번 핸드는 SmallBlind를 아무도 내지 않음
+
+	// 액션 시간 제한 (shotclock.go 참고)
+	ActionTimeout  time.Duration // 0이면 시간 제한 없음
+	TimeBankSize   time.Duration // 방에 들어오는 플레이어마다 주어지는 타임뱅크
+	ActionDeadline time.Time     // 현재 플레이어가 액션해야하는 마감 시간 (타이머가 없으면 zero value)
+	RunoutDelay    time.Duration // 올인 런아웃에서 스트릿 사이의 간격 (runout.go 참고)
+
 	// 처음 베팅하는 플레이어의 인덱스 (Players 배열에서 인덱싱을 하기 위함)
 	// 새 게임마다 1씩 증가함
 	FirstPlayerIdx   uint
 	IsFirstPlayerBet bool // 첫 플레이어가 베팅을 했는지를 체크
 	CurrentPlayerIdx uint
 	BetLeaderIdx     uint // 누군가 베팅을 추가로하면 해당 플레이어 이전까지 다시 베팅을 돌아야하기 때문에 저장해둠
+	AggressorSeat    int  // 이번 스트릿에서 마지막으로 베팅하거나 레이즈한 플레이어의 자리 (없으면 NoSeat, 쇼다운 순서에 사용)
+	Reveals          []ShowdownReveal // 이번 핸드의 쇼다운에서 지금까지 공개하거나 버린 순서 (showdown.go 참고)
+
+	// 덱을 섞을 때 사용 (테스트나 리플레이용으로 nil이면 ServerSeed와 ClientSeeds로 섞음)
+	// redis에 저장되지 않으므로 다시 불러온 게임은 항상 공정한 셔플을 사용함
+	shuffler card.Shuffler
+	// 액션 마감 시간을 계산할 때 사용 (테스트용으로 nil이면 time.Now를 사용함)
+	clock func() time.Time
+
+	// 이벤트 로그 (event.go 참고)
+	Version  uint64  // 마지막으로 기록된 이벤트의 Seq
+	pending  []Event // 아직 이벤트 로그에 저장하지 않은 이벤트들
+	nextSeed string  // ReplayGame에서 다음에 만들 ServerSeed 대신 사용할 기록된 시드
+}
+
+type GameOption func(*Game)
+
+// WithAnte는 매 핸드 시작 시 모든 플레이어가 내야하는 앤티를 지정함
+func WithAnte(ante uint64) GameOption {
+	return func(g *Game) {
+		g.Ante = ante
+	}
+}
+
+// WithVariant는 방의 덱 종류를 지정함 (ShortDeck이면 36장 덱과 숏덱 족보를 사용)
+func WithVariant(variant card.Variant) GameOption {
+	return func(g *Game) {
+		g.Variant = variant
+	}
+}
+
+// WithShuffler는 게임에서 새 덱을 만들 때 사용할 Shuffler를 지정함
+// 테스트나 리플레이에서 같은 순서의 덱을 만들기 위해 사용
+func WithShuffler(shuffler card.Shuffler) GameOption {
+	return func(g *Game) {
+		g.shuffler = shuffler
+	}
 }
 
-func NewGame(roomId uuid.UUID, roomLimit uint, hostPlayer *Player, minBetAmount uint64) *Game {
+func NewGame(roomId uuid.UUID, roomLimit uint, hostPlayer *Player, minBetAmount uint64, opts ...GameOption) *Game {
 	game := Game{
 		RoomId: roomId,
 		RoomLimit: roomLimit,
@@ -55,10 +105,25 @@ func NewGame(roomId uuid.UUID, roomLimit uint, hostPlayer *Player, minBetAmount
 		TotalBet:   0,
 		CurrentBet: 0,
 		IsStarted:  false,
-		Deck:       card.NewDeck(),
-		Status:     gameconst.FreeFlop,
+		Phase:      PhaseWaitingForPlayers,
+		ButtonSeat: NoSeat,
+		SmallBlindSeat: NoSeat,
+		BigBlindSeat: NoSeat,
+		AggressorSeat: NoSeat,
+		ActionTimeout: DefaultActionTimeout,
+		TimeBankSize: DefaultTimeBank,
+		RunoutDelay: DefaultRunoutDelay,
 	}
+	for _, opt := range opts {
+		opt(&game)
+	}
+	game.newSeed()
+	game.Deck = game.newDeck()
+	hostPlayer.Seat = 0
+	hostPlayer.TimeBank = game.TimeBankSize
 	game.Players = append(game.Players, hostPlayer)
+	game.record(Event{Type: GameCreated, Config: game.config(), Seed: game.ServerSeed})
+	game.recordSeated(hostPlayer)
 	memento := NewGameMemento(game)
 	game.Memento = memento
 	return &game
@@ -80,7 +145,11 @@ func (g *Game) GetFirstPlayer() *Player {
 	return g.Players[g.FirstPlayerIdx]
 }
 
+// SmallBlind가 dead면 nil을 리턴함
 func (g *Game) GetSmallBlind() *Player {
+	if g.IsSmallBlindDead {
+		return nil
+	}
 	return g.Players[g.SmallBlindIdx]
 }
 
@@ -106,40 +175,124 @@ func (g *Game) FindPlayer(nickname string) *Player {
 	return nil 
 }
 
-func (g *Game) StartGame() {
-	g.setPlayers()
-	g.GiveCardsToPlayers()
+func (g *Game) StartGame() error {
+	// 이전 핸드가 끝나고 InitGame이 호출된 후에만 시작할 수 있음
+	if !g.Phase.CanTransition(PhaseBlinds) {
+		return gameerror.InvalidPhaseTransition
+	}
+	// 이벤트에 기록되는 시간과 첫 플레이어의 마감 시간이 같아야함 (freezeClock 참고)
+	defer g.freezeClock()()
+
+	if _, err := g.setPlayers(); err != nil {
+		return err
+	}
+	if err := g.transition(PhaseBlinds); err != nil {
+		return err
+	}
+	// 핸드 시작 전까지 모인 ClientSeed들을 반영해서 덱을 다시 섞음
+	g.Deck = g.newDeck()
+	g.record(Event{Type: HandStarted, Seat: g.ButtonSeat, Cards: append([]card.Card(nil), *g.Deck...)})
+	if err := g.GiveCardsToPlayers(); err != nil {
+		return err
+	}
+
+	g.postBlinds()
+	g.setFirstToAct()
+	return g.startPreflop()
+}
+
+// setFirstToAct은 앤티나 블라인드를 내다가 올인된 플레이어를 건너뛰고 프리플랍에서 처음 액션할 플레이어를 정함
+// 아무도 액션할 수 없으면 그대로 두고 IsRunout으로 바로 런아웃함
+func (g *Game) setFirstToAct() {
+	g.FirstPlayerIdx = g.firstToActFrom(g.FirstPlayerIdx)
+	g.CurrentPlayerIdx = g.FirstPlayerIdx
+	g.BetLeaderIdx = g.FirstPlayerIdx
+}
+
+// startPreflop은 블라인드를 모두 낸 후 프리플랍 베팅을 시작함
+func (g *Game) startPreflop() error {
+	g.MinRaise = g.BigBlindAmount()
+	g.AggressorSeat = NoSeat
+	if err := g.transition(PhasePreflop); err != nil {
+		return err
+	}
+	g.IsStarted = true
+	g.startActionClock()
+	return nil
 }
 
 // 게임이 종료되면 초기화용
 func (g *Game) InitGame() {
+	g.resetHand()
+	g.record(Event{Type: HandReset, Seed: g.ServerSeed})
+}
+
+func (g *Game) resetHand() {
 	g.TotalBet = 0
 	g.CurrentBet = 0  
+	g.MinRaise = 0
 	g.IsStarted = false
-	g.Deck = card.NewDeck()
-	g.Status = gameconst.FreeFlop
+	g.stopActionClock()
+	g.newSeed()
+	g.Deck = g.newDeck()
+	g.Board = nil
+	g.Reveals = nil
+	g.Phase = PhaseWaitingForPlayers // 핸드 도중에 초기화하는 경우도 있으므로 전이 규칙을 따르지 않음
 	g.IsFirstPlayerBet = false 
 
 	g.removeLeftPlayers()
 	
 	for _, p := range g.Players {
+		// 돈을 모두 잃은 플레이어는 자리를 비운 것으로 처리해서 다음 핸드부터 카드를 받지 않음
+		if p.GameBalance == 0 {
+			p.IsReady = false
+		}
 		p.CurrentBet = 0
 		p.TotalBet = 0
 		p.IsDead = false 
 		p.IsAllIn = false 
+		p.HasActed = false
 		p.Hands = nil 
-		p.HandsRank = card.HandsRank(card.None)
-		p.HighCard = card.None
+		p.HandValue = 0
 		p.BestCards = nil 
 	}
 }
 
-func (g *Game) GiveCardsToPlayers() {
-	validPlayers := g.GetValidPlayers()
+func (g *Game) newDeck() *card.Deck {
+	if g.shuffler != nil {
+		return card.NewDeck(card.WithShuffler(g.shuffler), card.WithVariant(g.Variant))
+	}
+	return card.DeriveDeck(g.ServerSeed, g.clientSeedValues(), card.WithVariant(g.Variant))
+}
+
+// 실제 딜러처럼 버튼 왼쪽 플레이어부터 한장씩 돌아가며 두 바퀴 나눠줌
+// (3명 이상이면 SmallBlind부터, 2명이면 버튼이 SmallBlind이므로 BigBlind부터)
+func (g *Game) GiveCardsToPlayers() error {
+	var dealOrder []*Player
+	for _, p := range g.seatsFrom(g.leftOfButtonIdx()) {
+		if p.IsReady && !p.IsDead && !p.IsLeft {
+			dealOrder = append(dealOrder, p)
+		}
+	}
+
+	if g.Deck.Remaining() < len(dealOrder)*2 {
+		return carderror.NotEnoughCards
+	}
+
+	for round := 0; round < 2; round++ {
+		for _, p := range dealOrder {
+			c, err := g.Deck.GetCard()
+			if err != nil {
+				return err
+			}
+			p.Hands = append(p.Hands, c)
+		}
+	}
 
-	for i := 0; i < len(validPlayers); i++ {
-		g.Players[i].Hands = append(g.Players[i].Hands, g.Deck.GetCard(), g.Deck.GetCard())
+	for _, p := range dealOrder {
+		g.record(Event{Type: CardsDealt, Nickname: p.Nickname, Cards: append([]card.Card(nil), p.Hands...)})
 	}
+	return nil
 }
 
 // 현재 플레이어들 중 나가지도 않고 죽지도 않고 준비도 된 플레이어들만 리턴
@@ -161,83 +314,6 @@ func (g *Game) ClearPlayersCurrentBet() {
 	}
 }
 
-// 리턴되는 배열에는 1명 이상의 승리자가 들어가게 되는데 
-// 배열에 포함되는 승리자들이 2명 이상인 경우 단순히 1/n으로 나누면 안됨 
-// (돈이 상대적으로 없는 사용자가 올인하고 나머지 플레이어들은 추가 베팅이 가능하기 때문)
-func (g *Game) GetWinnersAndLosers() (winners []*Player, losers []*Player, err error) {
-	// 죽거나 나가지 않은 플레이어들로 승리자 계산해야함 
-	validPlayers := g.GetValidPlayers()
-
-	if len(validPlayers) == 0 {
-		return nil, nil, errors.New("zero player")
-	}
-
-	if len(validPlayers) == 1 {
-		return []*Player{validPlayers[0]}, nil, nil 
-	}
-
-	// 승리자/패배자는 여러 명이 나올 수 있으므로 배열을 이용함
-
-	// 베팅을 가장 많이 한 플레이어 순으로 정렬함 
-	// (중간에 올인해버린 플레이어들도 winner로 고려하기 위해)
-	sort.Slice(validPlayers, func(i, j int) bool {
-		return validPlayers[i].TotalBet > validPlayers[j].TotalBet
-	})
-
-	// 첫번째 플레이어를 우선 승리자로 지정해놓고 이후 플레이어들과 비교해나감
-	winners = append(winners, validPlayers[0])
-	
-	for i := 1; i < len(validPlayers); i++ {
-		winner := winners[0]
-		player := validPlayers[i]
-
-		switch compare(winner, player) {
-		case Player1Win:
-			continue
-		case Player2Win:
-			if winner.TotalBet == player.TotalBet {
-				// 배열에 기존 승리자가 여러명 있을 수도 있으니 아예 비워야함
-				winners = nil
-				winners = append(winners, player)
-			} else {
-				// 지금 이긴 플레이어는 중간에 올인하여 돈이 부족한 플레이어임 
-				// 즉 지금 이긴 플레이어에게 진 플레이어들도 나머지 돈을 배분 받을 수 있음 
-				winners = append(winners, player)
-			}
-			
-		case Draw:
-			// 기존 승리자들과 같은 패이므로 승리자에 추가
-			winners = append(winners, player)
-		}
-	}
-
-	// 승리자들에 대해 돈이 적은 순으로 정렬 
-	// 예를 들어 0번 인덱스의 총 베팅액이 100이고 
-	// 1번 인덱스의 총 베팅액이 150이라면 
-	// 0번 인덱스에게 각 플레이어가 100만큼씩 주고 
-	// 1번 인덱스에게 50씩 줘야함 
-	sort.Slice(winners, func(i, j int) bool {
-		return winners[i].TotalBet < winners[j].TotalBet
-	})
-
-	// 패자들 생성 
-	for _, p := range g.GetReadyPlayers() {
-		isExist := false 
-		for _, winner := range winners {
-			if p.Nickname == winner.Nickname {
-				isExist = true 
-				break 
-			}
-		}
-
-		if !isExist {
-			losers = append(losers, p)
-		}
-	}
-
-	return winners, losers, nil
-}
-
 func (g *Game) setPlayers() ([]string, error) {
 	
 	playerCnt := 0
@@ -251,6 +327,13 @@ func (g *Game) setPlayers() ([]string, error) {
 		return nil, gameerror.LackOfPlayers
 	}
 
+	// 돈을 모두 잃은 플레이어는 준비 상태로 남아있어도 카드를 받지 않음 (InitGame에서 준비를 풀지만 한번 더 확인함)
+	for _, p := range g.Players {
+		if p.GameBalance == 0 {
+			p.IsReady = false
+		}
+	}
+
 	var readyPlayersName []string 
 	readyCnt := 0
 	for i := 0; i < len(g.Players); i++ {
@@ -263,35 +346,15 @@ func (g *Game) setPlayers() ([]string, error) {
 		return nil, gameerror.NotEnoughPlayersReady
 	}
 
-	// 게임이 처음 시작되는 경우와 이미 몇판 진행되고 있는지에 따라 나눔
-	// (이미 진행되고 있었다면 이전 게임의 플레이어들의 순서를 기준으로 세팅해야되기 때문)
-	var isFirstGame bool
+	// 이전 핸드의 버튼과 블라인드 자리를 기준으로 이번 핸드의 버튼과 블라인드를 정함
+	g.setBlinds(readyCnt)
 
-	if g.SmallBlindIdx == 0 && g.BigBlindIdx == 0 {
-		isFirstGame = true
+	if readyCnt == 2 {
+		// 헤즈업에서는 버튼이 SmallBlind를 내고 프리플랍에서 먼저 액션함
+		// (플랍부터는 BigBlind가 먼저 액션함, ResetBettingRound 참고)
+		g.FirstPlayerIdx = g.SmallBlindIdx
 	} else {
-		isFirstGame = false
-	}
-
-	if isFirstGame {
-		if len(g.Players) > 2 {
-			g.SmallBlindIdx = getReadyPlayerIdx(g.Players, 0)
-			g.BigBlindIdx = getReadyPlayerIdx(g.Players, g.SmallBlindIdx + 1)
-			g.FirstPlayerIdx = getReadyPlayerIdx(g.Players, g.BigBlindIdx + 1)
-		} else { // 플레이어가 2명 밖에 없는 경우 (이 함수의 첫 부분 검사에 의해 최소 2명은 Ready 상태이므로 0번과 1번 인덱스가 모두 Ready 상태임)
-			g.SmallBlindIdx = 0
-			g.BigBlindIdx = 1
-			g.FirstPlayerIdx = g.SmallBlindIdx
-		}
-	} else { // 기존에 진행되던 순서가 있는 경우
-		if len(g.Players) > 2 {
-			g.SmallBlindIdx = getReadyPlayerIdx(g.Players, g.SmallBlindIdx + 1)
-			g.BigBlindIdx = getReadyPlayerIdx(g.Players, g.SmallBlindIdx + 1)
-			g.FirstPlayerIdx = getReadyPlayerIdx(g.Players, g.BigBlindIdx + 1)
-		} else { // 플레이어가 2명만 있는 경우
-			g.SmallBlindIdx, g.BigBlindIdx = g.BigBlindIdx, g.SmallBlindIdx
-			g.FirstPlayerIdx = g.SmallBlindIdx
-		}
+		g.FirstPlayerIdx = getReadyPlayerIdx(g.Players, getNextIdx(g.Players, g.BigBlindIdx))
 	}
 
 	g.CurrentPlayerIdx = g.FirstPlayerIdx
@@ -302,27 +365,17 @@ func (g *Game) setPlayers() ([]string, error) {
 }
 
 
-// 나간 플레이어들 고려해서 인덱스 변경해야함
-// smallBlind와 bigBlind가 바뀌었는지 리턴
+// 나간 플레이어들을 실제로 제거함
+// 버튼과 블라인드는 인덱스가 아닌 자리 번호로 기억하므로 인덱스가 당겨져도 다음 핸드에 영향이 없음
 func (g *Game) removeLeftPlayers() {
-	var indexesToRemove []int
-
-	for idx, p := range g.Players {
-		if p.IsLeft {
-			if g.SmallBlindIdx == uint(idx) {
-				g.SmallBlindIdx = getNextIdx(g.Players, uint(idx))
-			}
-			if g.BigBlindIdx == uint(idx) {
-				g.BigBlindIdx = getNextIdx(g.Players, uint(idx))
-			}
+	var remainingPlayers []*Player
 
-			indexesToRemove = append(indexesToRemove, idx)
+	for _, p := range g.Players {
+		if !p.IsLeft {
+			remainingPlayers = append(remainingPlayers, p)
 		}
 	}
-
-	for _, idx := range indexesToRemove {
-		g.Players = removePlayerByIndex(g.Players, idx)
-	}
+	g.Players = remainingPlayers
 }
 
 // 함수 인자로 들어온 인덱스에 해당하는 플레이어가 Ready 상태면 해당 인덱스를 리턴하고
@@ -365,72 +418,6 @@ func (g *Game) GetNextPlayerIdx() (uint, error) {
 	return idx, nil
 }
 
-func removePlayerByIndex(players []*Player, s int) []*Player {
-	return append(players[:s], players[s+1:]...)
-}
-
-// 두 플레이어 간의 bestCards를 비교해서 이긴 플레이어를 리턴
-// 둘이 같다면 Draw를 리턴
-// ** 각 플레이어들의 bestCards는 정렬되어 있음 (bestCards를 만드는 과정에서 정렬 함수가 쓰임)
-func compare(player1 *Player, player2 *Player) CardCompareResult {
-	if player1.HandsRank > player2.HandsRank {
-		return Player1Win
-	} else if player1.HandsRank < player2.HandsRank {
-		return Player2Win
-	} else {
-		if player1.HighCard > player2.HighCard {
-			return Player1Win
-		} else if player1.HighCard < player2.HighCard {
-			return Player2Win
-		} else {
-			for i := len(player1.BestCards) - 1; i >= 0; i-- {
-				if player1.BestCards[i].Rank > player2.BestCards[i].Rank {
-					return Player1Win
-				} else if player1.BestCards[i].Rank < player2.BestCards[i].Rank {
-					return Player2Win
-				}
-			}
-			return Draw
-		}
-	}
-}
-
-func (g *Game) Undo() {
-	memento := g.Memento
-
-	g.RoomId = memento.RoomId
-	g.RoomLimit = memento.RoomLimit
-	g.TotalBet = memento.TotalBet
-	g.CurrentBet = memento.CurrentBet
-	g.IsStarted = memento.IsStarted
-	g.Deck = memento.Deck
-	g.Status = memento.Status
-	g.SmallBlindIdx = memento.SmallBlindIdx
-	g.BigBlindIdx = memento.BigBlindIdx
-	g.FirstPlayerIdx = memento.FirstPlayerIdx
-	g.IsFirstPlayerBet = memento.IsFirstPlayerBet
-	g.CurrentPlayerIdx = memento.CurrentPlayerIdx
-	g.BetLeaderIdx = memento.BetLeaderIdx
-}
-
-func (g *Game) SetMemento() {
-	memento := g.Memento
-
-	memento.RoomId = g.RoomId
-	memento.RoomLimit = g.RoomLimit
-	memento.TotalBet = g.TotalBet
-	memento.CurrentBet = g.CurrentBet
-	memento.IsStarted = g.IsStarted
-	memento.Deck = g.Deck
-	memento.Status = g.Status
-	memento.SmallBlindIdx = g.SmallBlindIdx
-	memento.BigBlindIdx = g.BigBlindIdx
-	memento.FirstPlayerIdx = g.FirstPlayerIdx
-	memento.IsFirstPlayerBet = g.IsFirstPlayerBet
-	memento.CurrentPlayerIdx = g.CurrentPlayerIdx
-	memento.BetLeaderIdx = g.BetLeaderIdx
-}
-
 // redis에 struct를 저장/가져오기위해 구현해야함
 func (g Game)MarshalBinary() ([]byte, error) {
     return json.Marshal(g)
@@ -444,30 +431,48 @@ func (g *Game) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+// GameMemento는 액션을 처리하기 전의 게임 전체를 깊은 복사로 저장해둔 스냅샷
+// 플레이어, 덱, 보드, 아직 저장하지 않은 이벤트까지 모두 들어있으므로 Undo하면 한번에 스냅샷을 찍을 때의 상태로 돌아감
 type GameMemento struct {
-	RoomId uuid.UUID
-	RoomLimit uint 
-	TotalBet   uint64           
-	CurrentBet uint64           
-	IsStarted  bool             
-	Deck            *card.Deck
-	Status          string                    
-	SmallBlindIdx uint
-	BigBlindIdx   uint
-	FirstPlayerIdx   uint
-	IsFirstPlayerBet bool 
-	CurrentPlayerIdx uint
-	BetLeaderIdx     uint 
+	game Game
 }
 
 func NewGameMemento(game Game) *GameMemento {
-	return &GameMemento{
-		RoomId: game.RoomId,
-		RoomLimit: game.RoomLimit,
-		TotalBet: game.TotalBet,
-		CurrentBet: game.CurrentBet,
-		IsStarted: game.IsStarted,
-		Deck: game.Deck,
-		Status: game.Status,
-	}
-}
\ No newline at end of file
+	return &GameMemento{game: game.clone()}
+}
+
+// SetMemento는 현재 게임 전체를 스냅샷으로 저장함 (이전 스냅샷은 버려짐)
+func (g *Game) SetMemento() {
+	g.Memento = NewGameMemento(*g)
+}
+
+// Undo는 마지막 SetMemento 이후의 모든 변경을 되돌림 (여러 번 호출해도 같은 상태가 됨)
+// 플레이어들도 스냅샷에서 새로 복사되므로 Undo 전에 꺼내둔 *Player는 더 이상 게임의 플레이어가 아님
+func (g *Game) Undo() {
+	memento := g.Memento
+	if memento == nil {
+		return
+	}
+	*g = memento.game.clone()
+	g.Memento = memento
+}
+
+// clone은 원래 게임과 슬라이스나 포인터를 공유하지 않는 복사본을 만듦 (Memento는 복사하지 않음)
+func (g Game) clone() Game {
+	c := g
+	c.Memento = nil
+	c.Players = make([]*Player, len(g.Players))
+	for i, p := range g.Players {
+		c.Players[i] = p.clone()
+	}
+	if g.Deck != nil {
+		deck := make(card.Deck, len(*g.Deck))
+		copy(deck, *g.Deck)
+		c.Deck = &deck
+	}
+	c.Board = append([]card.Card(nil), g.Board...)
+	c.ClientSeeds = append([]ClientSeed(nil), g.ClientSeeds...)
+	c.Reveals = append([]ShowdownReveal(nil), g.Reveals...)
+	c.pending = append([]Event(nil), g.pending...)
+	return c
+}
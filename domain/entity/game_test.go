@@ -0,0 +1,362 @@
+package entity
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/google/uuid"
+)
+
+func mustParseCards(s string) []card.Card {
+	cards, err := card.ParseCards(s)
+	if err != nil {
+		panic(err)
+	}
+	return cards
+}
+
+func TestNewGameWithShuffler(t *testing.T) {
+	host := NewPlayer(1, "host", 1000, 1000)
+
+	game1 := NewGame(uuid.New(), 7, host, 10, WithShuffler(card.NewSeededShuffler(1)))
+	game2 := NewGame(uuid.New(), 7, host, 10, WithShuffler(card.NewSeededShuffler(1)))
+	if !reflect.DeepEqual(game1.Deck, game2.Deck) {
+		t.Error("games with same seed should have same deck")
+	}
+
+	// 게임이 끝나고 새 덱을 만들 때도 같은 Shuffler를 사용해야함
+	game1.InitGame()
+	game2.InitGame()
+	if !reflect.DeepEqual(game1.Deck, game2.Deck) {
+		t.Error("decks after InitGame should be same")
+	}
+}
+
+func TestFairShuffle(t *testing.T) {
+	host := NewPlayer(1, "host", 1000, 1000)
+	host.IsReady = true
+	guest := NewPlayer(2, "guest", 1000, 1000)
+	guest.IsReady = true
+
+	game := NewGame(uuid.New(), 7, host, 10)
+	if err := game.AddPlayer(guest); err != nil {
+		t.Fatal(err)
+	}
+
+	if game.SeedCommitment != card.CommitSeed(game.ServerSeed) {
+		t.Error("commitment should be hash of server seed")
+	}
+
+	if err := game.AddClientSeed("host", "first"); err != nil {
+		t.Error(err.Error())
+	}
+	if err := game.AddClientSeed("guest", "guest-seed"); err != nil {
+		t.Error(err.Error())
+	}
+	// 같은 플레이어가 다시 보내면 덮어씀
+	if err := game.AddClientSeed("host", "host-seed"); err != nil {
+		t.Error(err.Error())
+	}
+	if err := game.AddClientSeed("host", "a,b"); err != gameerror.InvalidClientSeed {
+		t.Error("seed with comma should return InvalidClientSeed")
+	}
+	if err := game.AddClientSeed("nobody", "seed"); err != gameerror.NoPlayerExists {
+		t.Error("unknown player should return NoPlayerExists")
+	}
+
+	if err := game.StartGame(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	proof := game.GetFairnessProof()
+	if !reflect.DeepEqual(proof.ClientSeeds, []string{"host-seed", "guest-seed"}) {
+		t.Errorf("unexpected client seeds: %v", proof.ClientSeeds)
+	}
+
+	// 공개된 시드로 만든 덱에서 나눠준 카드들이 실제 플레이어들의 카드와 같아야함
+	derived := *card.DeriveDeck(proof.ServerSeed, proof.ClientSeeds)
+	// 2명이면 BigBlind(guest)부터 한장씩 나눠줌
+	dealt := []card.Card{derived[50], derived[48], derived[51], derived[49]}
+	var hands []card.Card
+	for _, p := range game.Players {
+		hands = append(hands, p.Hands...)
+	}
+	if !reflect.DeepEqual(hands, dealt) {
+		t.Error("players' hands should be dealt from the derived deck")
+	}
+
+	oldCommitment := game.SeedCommitment
+	game.InitGame()
+	if game.SeedCommitment == oldCommitment || len(game.ClientSeeds) != 0 {
+		t.Error("new hand should have new seed")
+	}
+}
+
+func TestGiveCardsToPlayers(t *testing.T) {
+	tests := []struct {
+		name          string
+		readyPlayers  []bool
+		smallBlindIdx uint
+		bigBlindIdx   uint
+		expected      []string // Players 순서대로 받아야하는 카드
+	}{
+		{
+			name:          "starts from small blind",
+			readyPlayers:  []bool{true, true, true},
+			smallBlindIdx: 1,
+			bigBlindIdx:   2,
+			expected:      []string{"4s 7s", "2s 5s", "3s 6s"},
+		},
+		{
+			name:          "heads up starts from big blind",
+			readyPlayers:  []bool{true, true},
+			smallBlindIdx: 0,
+			bigBlindIdx:   1,
+			expected:      []string{"3s 5s", "2s 4s"},
+		},
+		{
+			name:          "skips players who are not ready",
+			readyPlayers:  []bool{true, false, true, true},
+			smallBlindIdx: 2,
+			bigBlindIdx:   3,
+			expected:      []string{"4s 7s", "", "2s 5s", "3s 6s"},
+		},
+	}
+
+	for _, tt := range tests {
+		host := NewPlayer(0, "p0", 1000, 1000)
+		game := NewGame(uuid.New(), 7, host, 10)
+		for i := 1; i < len(tt.readyPlayers); i++ {
+			game.Players = append(game.Players, NewPlayer(int64(i), fmt.Sprintf("p%d", i), 1000, 1000))
+		}
+		for i, ready := range tt.readyPlayers {
+			game.Players[i].IsReady = ready
+		}
+		game.SmallBlindIdx = tt.smallBlindIdx
+		game.BigBlindIdx = tt.bigBlindIdx
+		game.Deck = card.NewDeckFromOrder(mustParseCards("2s 3s 4s 5s 6s 7s 8s"))
+
+		if err := game.GiveCardsToPlayers(); err != nil {
+			t.Fatalf("%s: %s", tt.name, err.Error())
+		}
+
+		for i, p := range game.Players {
+			if card.FormatCards(p.Hands) != tt.expected[i] {
+				t.Errorf("%s: %s should have [%s] but got [%s]", tt.name, p.Nickname, tt.expected[i], card.FormatCards(p.Hands))
+			}
+		}
+	}
+}
+
+func TestGiveCardsToPlayersWithEmptyDeck(t *testing.T) {
+	host := NewPlayer(0, "host", 1000, 1000)
+	host.IsReady = true
+	guest := NewPlayer(1, "guest", 1000, 1000)
+	guest.IsReady = true
+
+	game := NewGame(uuid.New(), 7, host, 10)
+	game.Players = append(game.Players, guest)
+	game.SmallBlindIdx, game.BigBlindIdx = 0, 1
+	game.Deck = card.NewDeckFromOrder(mustParseCards("2s 3s 4s"))
+
+	if err := game.GiveCardsToPlayers(); err != carderror.NotEnoughCards {
+		t.Error("it should return NotEnoughCards")
+	}
+	if game.Deck.Remaining() != 3 || host.Hands != nil || guest.Hands != nil {
+		t.Error("no cards should be dealt when deck doesn't have enough cards")
+	}
+}
+
+func TestNewGameWithVariant(t *testing.T) {
+	host := NewPlayer(1, "host", 1000, 1000)
+
+	game := NewGame(uuid.New(), 7, host, 10, WithVariant(card.ShortDeck))
+	if game.Deck.Remaining() != 36 {
+		t.Error("short deck game should use 36 cards")
+	}
+
+	game.InitGame()
+	if game.Deck.Remaining() != 36 {
+		t.Error("short deck should be kept after InitGame")
+	}
+
+	proof := game.GetFairnessProof()
+	if err := card.VerifyDeck(proof.ServerSeed, proof.SeedCommitment, proof.ClientSeeds, *game.Deck, card.WithVariant(proof.Variant)); err != nil {
+		t.Error(err.Error())
+	}
+}
+
+// 체크나 콜만 해서 이번 스트릿의 베팅을 끝냄
+func checkDownRound(game *Game) error {
+	for {
+		p := game.Players[game.CurrentPlayerIdx]
+		action := Action{Type: Call}
+		if game.amountToCall(p) == 0 {
+			action = Action{Type: Check}
+		}
+
+		result, err := game.HandleAction(p.Nickname, action)
+		if err != nil {
+			return err
+		}
+		if result.IsRoundEnd {
+			return nil
+		}
+	}
+}
+
+func TestActionOrder(t *testing.T) {
+	type hand struct {
+		leave         []string // 이전 핸드 도중에 나간 플레이어들
+		unready       []string // 이번 핸드 전에 준비를 푼 플레이어들
+		smallBlind    string
+		bigBlind      string
+		preflopFirst  string
+		postflopFirst string
+	}
+
+	tests := []struct {
+		name     string
+		balances []uint64
+		hands    []hand
+	}{
+		{
+			name:     "heads up button acts first preflop and last after the flop",
+			balances: []uint64{1000, 1000},
+			hands: []hand{
+				{smallBlind: "p0", bigBlind: "p1", preflopFirst: "p0", postflopFirst: "p1"},
+				{smallBlind: "p1", bigBlind: "p0", preflopFirst: "p1", postflopFirst: "p0"},
+				{smallBlind: "p0", bigBlind: "p1", preflopFirst: "p0", postflopFirst: "p1"},
+			},
+		},
+		{
+			name:     "three players",
+			balances: []uint64{1000, 1000, 1000},
+			hands: []hand{
+				{smallBlind: "p0", bigBlind: "p1", preflopFirst: "p2", postflopFirst: "p0"},
+				{smallBlind: "p1", bigBlind: "p2", preflopFirst: "p0", postflopFirst: "p1"},
+				{smallBlind: "p2", bigBlind: "p0", preflopFirst: "p1", postflopFirst: "p2"},
+			},
+		},
+		{
+			name:     "small blind leaves and table becomes heads up",
+			balances: []uint64{1000, 1000, 1000},
+			hands: []hand{
+				{smallBlind: "p0", bigBlind: "p1", preflopFirst: "p2", postflopFirst: "p0"},
+				{smallBlind: "p1", bigBlind: "p2", preflopFirst: "p0", postflopFirst: "p1"},
+				{leave: []string{"p1"}, smallBlind: "p2", bigBlind: "p0", preflopFirst: "p2", postflopFirst: "p0"},
+				{smallBlind: "p0", bigBlind: "p2", preflopFirst: "p0", postflopFirst: "p2"},
+			},
+		},
+		{
+			name:     "player before the blinds leaves and table becomes heads up",
+			balances: []uint64{1000, 1000, 1000},
+			hands: []hand{
+				{smallBlind: "p0", bigBlind: "p1", preflopFirst: "p2", postflopFirst: "p0"},
+				{leave: []string{"p0"}, smallBlind: "p1", bigBlind: "p2", preflopFirst: "p1", postflopFirst: "p2"},
+				{smallBlind: "p2", bigBlind: "p1", preflopFirst: "p2", postflopFirst: "p1"},
+			},
+		},
+		{
+			name:     "player sits out and table becomes heads up",
+			balances: []uint64{1000, 1000, 1000},
+			hands: []hand{
+				{smallBlind: "p0", bigBlind: "p1", preflopFirst: "p2", postflopFirst: "p0"},
+				{unready: []string{"p2"}, smallBlind: "p1", bigBlind: "p0", preflopFirst: "p1", postflopFirst: "p0"},
+				{smallBlind: "p0", bigBlind: "p1", preflopFirst: "p0", postflopFirst: "p1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		game := newActionGame(tt.balances...)
+
+		for i, h := range tt.hands {
+			if i > 0 {
+				for _, nickname := range h.leave {
+					game.FindPlayer(nickname).IsLeft = true
+				}
+				game.InitGame()
+				for _, nickname := range h.unready {
+					game.FindPlayer(nickname).IsReady = false
+				}
+				if err := game.StartGame(); err != nil {
+					t.Fatalf("%s: hand %d: %s", tt.name, i, err.Error())
+				}
+			}
+
+			if sb, bb := game.GetSmallBlind().Nickname, game.GetBigBlind().Nickname; sb != h.smallBlind || bb != h.bigBlind {
+				t.Errorf("%s: hand %d: expected blinds %s/%s but got %s/%s", tt.name, i, h.smallBlind, h.bigBlind, sb, bb)
+			}
+			if first := game.Players[game.CurrentPlayerIdx].Nickname; first != h.preflopFirst {
+				t.Errorf("%s: hand %d: expected %s to act first preflop but got %s", tt.name, i, h.preflopFirst, first)
+			}
+
+			if err := checkDownRound(game); err != nil {
+				t.Fatalf("%s: hand %d: %s", tt.name, i, err.Error())
+			}
+			game.ResetBettingRound()
+
+			if first := game.Players[game.CurrentPlayerIdx].Nickname; first != h.postflopFirst {
+				t.Errorf("%s: hand %d: expected %s to act first after the flop but got %s", tt.name, i, h.postflopFirst, first)
+			}
+		}
+	}
+}
+
+func TestMemento(t *testing.T) {
+	game := newActionGame(1000, 1000, 1000)
+	game.SetMemento()
+	before, _ := game.MarshalBinary()
+	pending := len(game.pending)
+
+	// 스냅샷과 공유하는 슬라이스가 있으면 안쪽 값을 바꿨을 때 스냅샷도 바뀜
+	if _, err := game.HandleAction("p2", Action{Type: Raise, Amount: 60}); err != nil {
+		t.Fatal(err)
+	}
+	game.Players[0].Hands[0] = game.Players[1].Hands[0]
+	(*game.Deck)[0] = game.Players[1].Hands[1]
+	if err := game.dealBoard(0, 3); err != nil {
+		t.Fatal(err)
+	}
+	game.Settle()
+	game.InitGame()
+
+	game.Undo()
+	after, _ := game.MarshalBinary()
+	if string(before) != string(after) {
+		t.Errorf("game should be restored to\n%s\nbut got\n%s", before, after)
+	}
+	if len(game.pending) != pending {
+		t.Errorf("events recorded after the snapshot should be dropped but got %d events", len(game.pending)-pending)
+	}
+}
+
+func TestApplyRollback(t *testing.T) {
+	game := newActionGame(1000, 1000, 1000)
+	for _, step := range []actionStep{{"p2", Action{Type: Call}, nil}, {"p0", Action{Type: Call}, nil}} {
+		if _, err := game.Apply(step.player, step.action); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 마지막 체크로 프리플랍이 끝나지만 덱이 비어서 플랍을 깔 수 없음
+	*game.Deck = card.Deck{}
+	before, _ := game.MarshalBinary()
+	pending := len(game.pending)
+
+	if _, err := game.Apply("p1", Action{Type: Check}); err == nil {
+		t.Fatal("flop shouldn't be dealt from an empty deck")
+	}
+	after, _ := game.MarshalBinary()
+	if string(before) != string(after) {
+		t.Errorf("failed action should be rolled back to\n%s\nbut got\n%s", before, after)
+	}
+	if len(game.pending) != pending {
+		t.Errorf("failed action shouldn't record events but got %d events", len(game.pending)-pending)
+	}
+}
@@ -0,0 +1,246 @@
+package entity
+
+import (
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+)
+
+// Phase는 한 핸드가 진행되는 단계
+type Phase string
+
+const (
+	PhaseWaitingForPlayers Phase = "WaitingForPlayers" // 핸드 시작 전 (플레이어들이 준비하거나 시드를 보냄)
+	PhaseBlinds            Phase = "Blinds"            // 버튼과 블라인드를 정하고 카드를 나눠줌
+	PhasePreflop           Phase = "Preflop"
+	PhaseFlop              Phase = "Flop"
+	PhaseTurn              Phase = "Turn"
+	PhaseRiver             Phase = "River"
+	PhaseShowdown          Phase = "Showdown"     // 남은 플레이어들이 핸드를 공개함
+	PhaseHandComplete      Phase = "HandComplete" // 팟이 정산됨 (InitGame으로 다음 핸드를 준비해야함)
+)
+
+// 각 페이즈에서 넘어갈 수 있는 페이즈들
+// 베팅 페이즈에서는 다른 플레이어가 모두 폴드하면 바로 HandComplete로 넘어갈 수 있음
+var phaseTransitions = map[Phase][]Phase{
+	PhaseWaitingForPlayers: {PhaseBlinds},
+	PhaseBlinds:            {PhasePreflop},
+	PhasePreflop:           {PhaseFlop, PhaseHandComplete},
+	PhaseFlop:              {PhaseTurn, PhaseHandComplete},
+	PhaseTurn:              {PhaseRiver, PhaseHandComplete},
+	PhaseRiver:             {PhaseShowdown, PhaseHandComplete},
+	PhaseShowdown:          {PhaseHandComplete},
+	PhaseHandComplete:      {PhaseWaitingForPlayers},
+}
+
+// IsBetting은 플레이어들이 액션할 수 있는 페이즈인지
+func (p Phase) IsBetting() bool {
+	switch p {
+	case PhasePreflop, PhaseFlop, PhaseTurn, PhaseRiver:
+		return true
+	}
+	return false
+}
+
+// CanTransition은 현재 페이즈에서 to로 넘어갈 수 있는지
+func (p Phase) CanTransition(to Phase) bool {
+	for _, next := range phaseTransitions[p] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Game) transition(to Phase) error {
+	if !g.Phase.CanTransition(to) {
+		return gameerror.InvalidPhaseTransition
+	}
+	g.Phase = to
+	return nil
+}
+
+// Street는 올인 런아웃에서 자동으로 깔린 스트릿
+type Street struct {
+	Phase Phase
+	Board []card.Card // 이 스트릿까지 깔린 보드
+}
+
+// ApplyResult는 Apply로 액션을 처리한 결과
+type ApplyResult struct {
+	ActionResult
+	Phase   Phase            // 액션을 처리한 후의 페이즈
+	Runout  []Street         // 올인 런아웃에서 자동으로 깔린 스트릿들 (순서대로)
+	Reveals []ShowdownReveal // 쇼다운에서 공개한 순서 (폴드로 끝났으면 nil, 결정을 기다리는 중이면 지금까지의 공개)
+	Pots    []Pot            // 핸드가 끝났을 때 정산된 메인팟과 사이드팟
+	Winners []*Player
+	Losers  []*Player
+}
+
+// Apply는 플레이어의 액션을 처리하고 그 결과에 따라 다음 페이즈로 넘김
+// 베팅이 끝나면 다음 스트릿을 깔고, 한 명만 남으면 쇼다운 없이 정산하고,
+// 더 이상 베팅할 수 없으면 남은 보드를 모두 깔고, 리버가 끝나면 쇼다운 후 정산함
+// 쇼다운에서 핸드를 버릴 수 있는 플레이어의 결정이 필요하면 Phase는 Showdown이 되고 Decide로 이어서 진행함
+// 핸드가 끝나면 Phase는 HandComplete가 되고 잔고 반영 후 InitGame을 호출해야함
+//
+// 액션을 처리하기 전에 SetMemento로 게임 전체의 스냅샷을 찍어두고 중간에 실패하면 스냅샷으로 되돌림
+// 액션 이후의 처리(잔고 반영, 저장 등)가 실패해도 Undo로 액션 전의 상태로 되돌릴 수 있음
+func (g *Game) Apply(nickname string, action Action) (*ApplyResult, error) {
+	if !g.Phase.IsBetting() {
+		return nil, gameerror.GameNotStarted
+	}
+	defer g.freezeClock()()
+
+	g.SetMemento()
+	result, err := g.apply(nickname, action)
+	if err != nil {
+		g.Undo()
+		return nil, err
+	}
+	return result, nil
+}
+
+func (g *Game) apply(nickname string, action Action) (*ApplyResult, error) {
+	actionResult, err := g.HandleAction(nickname, action)
+	if err != nil {
+		return nil, err
+	}
+	result := &ApplyResult{ActionResult: *actionResult}
+	if err := g.advance(result); err != nil {
+		return nil, err
+	}
+
+	result.Phase = g.Phase
+	return result, nil
+}
+
+// advance는 베팅 라운드가 끝났으면 다음 스트릿, 런아웃, 쇼다운, 정산 중 하나로 넘김
+func (g *Game) advance(result *ApplyResult) error {
+	var err error
+	switch {
+	case result.IsHandOver:
+		err = g.completeHand(result)
+	case result.IsRunout && g.Phase != PhaseRiver:
+		err = g.runOut(result)
+	case result.IsRoundEnd && g.Phase == PhaseRiver:
+		err = g.showdown(result)
+	case result.IsRoundEnd:
+		if err = g.DealNextStreet(); err == nil {
+			g.ResetBettingRound()
+		}
+	}
+	return err
+}
+
+// Leave는 플레이어를 방에서 내보냄
+// 핸드가 진행 중이 아니면 바로 자리를 비우고 nil을 리턴함
+// 진행 중이면 그 핸드는 폴드한 것으로 보고 (올인했거나 쇼다운 중이어도 팟을 포기함) 핸드가 끝난 후 InitGame에서 자리를 비움
+// 나간 플레이어 때문에 베팅 라운드나 쇼다운이 끝날 수 있으므로 Apply처럼 다음 페이즈로 넘긴 결과를 리턴함
+// Apply처럼 처리하기 전에 SetMemento로 스냅샷을 찍어둠
+func (g *Game) Leave(nickname string) (*ApplyResult, error) {
+	// 이미 나간 플레이어는 핸드가 끝날 때까지 Players에 남아있지만 다시 나갈 수 없음
+	p := g.FindPlayer(nickname)
+	if p == nil || p.IsLeft {
+		return nil, gameerror.NoPlayerExists
+	}
+	defer g.freezeClock()()
+
+	if !g.IsStarted {
+		g.record(Event{Type: PlayerLeft, Nickname: nickname})
+		g.leave(p)
+		return nil, nil
+	}
+
+	g.SetMemento()
+	g.record(Event{Type: PlayerLeft, Nickname: nickname})
+	isShowdown := g.Phase == PhaseShowdown
+	result := &ApplyResult{ActionResult: *g.leave(p)}
+
+	var err error
+	if isShowdown {
+		err = g.continueShowdown(result.IsHandOver, result)
+	} else {
+		err = g.advance(result)
+	}
+	if err != nil {
+		g.Undo()
+		return nil, err
+	}
+	result.Phase = g.Phase
+	return result, nil
+}
+
+// leave는 p를 나간 것으로 표시하고 핸드가 진행 중이면 폴드처럼 처리함
+// p의 차례였거나 p가 나가서 베팅 라운드가 끝났으면 act처럼 다음 플레이어에게 넘기고
+// 쇼다운에서 p의 결정을 기다리고 있었으면 다음 플레이어로 넘김 (이때 IsHandOver는 공개가 모두 끝났는지)
+func (g *Game) leave(p *Player) *ActionResult {
+	isCurrent := g.IsStarted && g.Players[g.CurrentPlayerIdx] == p
+	p.IsLeft = true
+	if !g.IsStarted {
+		g.removeLeftPlayers()
+		return nil
+	}
+	p.IsDead = true
+
+	result := &ActionResult{Action: Action{Type: Fold}}
+	switch {
+	case g.Phase == PhaseShowdown:
+		if isCurrent {
+			result.IsHandOver = g.advanceShowdown()
+		}
+	case isCurrent || g.isBettingRoundOver():
+		g.passTurn(result)
+	}
+	return result
+}
+
+// RunOut은 블라인드와 앤티만으로 모두 올인되어 핸드 시작부터 아무도 액션할 수 없을 때 남은 보드를 깔고 정산함
+func (g *Game) RunOut() (*ApplyResult, error) {
+	if !g.IsRunout() {
+		return nil, gameerror.InvalidPhaseTransition
+	}
+	defer g.freezeClock()()
+
+	result := &ApplyResult{ActionResult: ActionResult{IsRoundEnd: true, IsRunout: true}}
+	if err := g.runOut(result); err != nil {
+		return nil, err
+	}
+	result.Phase = g.Phase
+	return result, nil
+}
+
+func (g *Game) runOut(result *ApplyResult) error {
+	g.stopActionClock()
+	for g.Phase != PhaseRiver {
+		if err := g.DealNextStreet(); err != nil {
+			return err
+		}
+		result.Runout = append(result.Runout, Street{Phase: g.Phase, Board: append([]card.Card(nil), g.Board...)})
+	}
+	return g.showdown(result)
+}
+
+func (g *Game) showdown(result *ApplyResult) error {
+	isDone, err := g.startShowdown()
+	if err != nil {
+		return err
+	}
+	return g.continueShowdown(isDone, result)
+}
+
+func (g *Game) completeHand(result *ApplyResult) error {
+	if err := g.transition(PhaseHandComplete); err != nil {
+		return err
+	}
+
+	result.Pots, result.Winners, result.Losers = g.Settle()
+	for _, pot := range result.Pots {
+		e := Event{Type: PotAwarded, Amount: pot.Amount}
+		for _, p := range pot.Winners {
+			e.Winners = append(e.Winners, p.Nickname)
+		}
+		g.record(e)
+	}
+	g.IsStarted = false
+	g.stopActionClock()
+	return nil
+}
@@ -0,0 +1,286 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/google/uuid"
+)
+
+func TestPhaseTransition(t *testing.T) {
+	tests := []struct {
+		from Phase
+		to   Phase
+		ok   bool
+	}{
+		{PhaseWaitingForPlayers, PhaseBlinds, true},
+		{PhaseWaitingForPlayers, PhasePreflop, false},
+		{PhaseBlinds, PhasePreflop, true},
+		{PhasePreflop, PhaseFlop, true},
+		{PhasePreflop, PhaseRiver, false},
+		{PhasePreflop, PhaseHandComplete, true},
+		{PhaseFlop, PhaseTurn, true},
+		{PhaseTurn, PhaseRiver, true},
+		{PhaseTurn, PhaseShowdown, false},
+		{PhaseRiver, PhaseShowdown, true},
+		{PhaseShowdown, PhaseHandComplete, true},
+		{PhaseShowdown, PhasePreflop, false},
+		{PhaseHandComplete, PhaseWaitingForPlayers, true},
+		{PhaseHandComplete, PhaseBlinds, false},
+	}
+
+	for _, tt := range tests {
+		game := &Game{Phase: tt.from}
+		err := game.transition(tt.to)
+		if tt.ok && (err != nil || game.Phase != tt.to) {
+			t.Errorf("%s -> %s should be allowed but got %v", tt.from, tt.to, err)
+		}
+		if !tt.ok && (err != gameerror.InvalidPhaseTransition || game.Phase != tt.from) {
+			t.Errorf("%s -> %s should not be allowed but got %v", tt.from, tt.to, err)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   []actionStep
+		phases  []Phase // 각 액션을 처리한 후의 페이즈
+		winners []string
+		reveals int
+	}{
+		{
+			name: "check down to showdown",
+			steps: []actionStep{
+				{"p2", Action{Type: Call}, nil},
+				{"p0", Action{Type: Call}, nil},
+				{"p1", Action{Type: Check}, nil},
+				{"p0", Action{Type: Check}, nil},
+				{"p1", Action{Type: Check}, nil},
+				{"p2", Action{Type: Check}, nil},
+				{"p0", Action{Type: Check}, nil},
+				{"p1", Action{Type: Check}, nil},
+				{"p2", Action{Type: Check}, nil},
+				{"p0", Action{Type: Check}, nil},
+				{"p1", Action{Type: Check}, nil},
+				{"p2", Action{Type: Check}, nil},
+			},
+			phases: []Phase{
+				PhasePreflop, PhasePreflop, PhaseFlop,
+				PhaseFlop, PhaseFlop, PhaseTurn,
+				PhaseTurn, PhaseTurn, PhaseRiver,
+				PhaseRiver, PhaseRiver, PhaseHandComplete,
+			},
+			reveals: 3,
+		},
+		{
+			name: "everyone else folds",
+			steps: []actionStep{
+				{"p2", Action{Type: Raise, Amount: 60}, nil},
+				{"p0", Action{Type: Fold}, nil},
+				{"p1", Action{Type: Fold}, nil},
+			},
+			phases:  []Phase{PhasePreflop, PhasePreflop, PhaseHandComplete},
+			winners: []string{"p2"},
+		},
+	}
+
+	for _, tt := range tests {
+		game := newActionGame(1000, 1000, 1000)
+
+		var result *ApplyResult
+		for i, step := range tt.steps {
+			res, err := game.Apply(step.player, step.action)
+			if err != step.err {
+				t.Fatalf("%s: step %d: expected %v but got %v", tt.name, i, step.err, err)
+			}
+			if res.Phase != tt.phases[i] || game.Phase != tt.phases[i] {
+				t.Errorf("%s: step %d: expected phase %s but got %s", tt.name, i, tt.phases[i], res.Phase)
+			}
+			result = res
+		}
+
+		if len(result.Reveals) != tt.reveals || len(result.Pots) == 0 || game.IsStarted {
+			t.Errorf("%s: expected settled hand with %d reveals but got %d", tt.name, tt.reveals, len(result.Reveals))
+		}
+		if tt.winners != nil && (len(result.Winners) != 1 || result.Winners[0].Nickname != tt.winners[0]) {
+			t.Errorf("%s: expected winners %v but got %v", tt.name, tt.winners, result.Winners)
+		}
+
+		// 정산이 끝난 핸드에는 액션할 수 없고 InitGame 후에만 다음 핸드를 시작할 수 있음
+		if _, err := game.Apply("p0", Action{Type: Check}); err != gameerror.GameNotStarted {
+			t.Errorf("%s: expected %v after hand complete but got %v", tt.name, gameerror.GameNotStarted, err)
+		}
+		if err := game.StartGame(); err != gameerror.InvalidPhaseTransition {
+			t.Errorf("%s: expected %v before InitGame but got %v", tt.name, gameerror.InvalidPhaseTransition, err)
+		}
+		game.InitGame()
+		if err := game.StartGame(); err != nil {
+			t.Errorf("%s: next hand should start after InitGame but got %v", tt.name, err)
+		}
+	}
+}
+
+func TestApplyRunout(t *testing.T) {
+	game := newActionGame(1000, 300)
+
+	if _, err := game.Apply("p0", Action{Type: AllIn}); err != nil {
+		t.Fatal(err)
+	}
+	result, err := game.Apply("p1", Action{Type: AllIn})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Phase{PhaseFlop, PhaseTurn, PhaseRiver}
+	if len(result.Runout) != len(expected) {
+		t.Fatalf("expected %d runout streets but got %d", len(expected), len(result.Runout))
+	}
+	for i, street := range result.Runout {
+		if street.Phase != expected[i] || len(street.Board) != i+3 {
+			t.Errorf("street %d: expected %s with %d cards but got %s with %d cards", i, expected[i], i+3, street.Phase, len(street.Board))
+		}
+	}
+	if result.Phase != PhaseHandComplete || len(result.Reveals) != 2 {
+		t.Errorf("runout should end with showdown but got phase %s and %d reveals", result.Phase, len(result.Reveals))
+	}
+}
+
+// 이벤트 로그로 다시 만들 수 있도록 SetReady로 준비한 세 명이 핸드를 시작한 게임을 만듦
+// (p0이 SmallBlind, p1이 BigBlind, p2가 첫 플레이어)
+func newRecordedGame(t *testing.T, start bool) *Game {
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	game := NewGame(uuid.New(), 7, NewPlayer(0, "p0", 10000, 1000), 10, WithClock(clock.Now))
+	for i := 1; i < 3; i++ {
+		if err := game.AddPlayer(NewPlayer(int64(i), fmt.Sprintf("p%d", i), 10000, 1000)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, p := range game.Players {
+		if err := game.SetReady(p.Nickname, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if start {
+		if err := game.StartGame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return game
+}
+
+func TestLeave(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   bool
+		before  []actionStep
+		leave   string
+		current string // 나간 후에 액션할 플레이어 (핸드가 끝났으면 비어있음)
+		phase   Phase
+		players int // 나간 후에 자리에 남아있는 플레이어 수
+	}{
+		{
+			name:    "player leaves before the hand and the seat is freed",
+			leave:   "p1",
+			players: 2,
+		},
+		{
+			name:    "current player leaves and the turn passes",
+			start:   true,
+			leave:   "p2",
+			current: "p0",
+			phase:   PhasePreflop,
+			players: 3,
+		},
+		{
+			name:    "player leaves out of turn and the hand becomes uncontested",
+			start:   true,
+			before:  []actionStep{{"p2", Action{Type: Fold}, nil}},
+			leave:   "p1",
+			phase:   PhaseHandComplete,
+			players: 3,
+		},
+		{
+			name:    "last player to act leaves and the next street is dealt",
+			start:   true,
+			before:  []actionStep{{"p2", Action{Type: Call}, nil}, {"p0", Action{Type: Call}, nil}},
+			leave:   "p1",
+			current: "p0",
+			phase:   PhaseFlop,
+			players: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		game := newRecordedGame(t, tt.start)
+		for _, step := range tt.before {
+			if _, err := game.Apply(step.player, step.action); err != step.err {
+				t.Fatalf("%s: %s %s: expected %v but got %v", tt.name, step.player, step.action.Type, step.err, err)
+			}
+		}
+
+		result, err := game.Leave(tt.leave)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err.Error())
+		}
+		if len(game.Players) != tt.players {
+			t.Errorf("%s: expected %d seated players but got %d", tt.name, tt.players, len(game.Players))
+		}
+		if !tt.start {
+			if result != nil || game.FindPlayer(tt.leave) != nil {
+				t.Errorf("%s: player should leave right away without a result", tt.name)
+			}
+		} else {
+			if result.Action.Type != Fold || result.Phase != tt.phase {
+				t.Errorf("%s: expected fold and %s but got %s and %s", tt.name, tt.phase, result.Action.Type, result.Phase)
+			}
+			if tt.current != "" && game.Players[game.CurrentPlayerIdx].Nickname != tt.current {
+				t.Errorf("%s: %s should act next but got %s", tt.name, tt.current, game.Players[game.CurrentPlayerIdx].Nickname)
+			}
+			if _, err := game.Leave(tt.leave); err != gameerror.NoPlayerExists {
+				t.Errorf("%s: player who already left should not leave again but got %v", tt.name, err)
+			}
+		}
+
+		replayed, err := ReplayGame(game.PendingEvents())
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err.Error())
+		}
+		expected, _ := game.MarshalBinary()
+		if data, _ := replayed.MarshalBinary(); string(data) != string(expected) {
+			t.Errorf("%s: replayed game should be\n%s\nbut got\n%s", tt.name, expected, data)
+		}
+	}
+}
+
+func TestLeaveDuringShowdown(t *testing.T) {
+	game := newShowdownGame(t, []uint64{1000, 1000, 1000}, nil, nil, []string{"p1", "p2"})
+	if err := game.showdown(&ApplyResult{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// p0이 공개한 후에 나가도 p1의 차례는 그대로임
+	if _, err := game.Leave("p0"); err != nil {
+		t.Fatal(err)
+	}
+	if game.Phase != PhaseShowdown || game.Players[game.CurrentPlayerIdx].Nickname != "p1" {
+		t.Fatalf("showdown should still wait for p1 but got %s", game.Players[game.CurrentPlayerIdx].Nickname)
+	}
+
+	// 결정을 기다리던 p1이 나가면 p2의 차례로 넘어가고 남은 p2가 팟을 가져감
+	result, err := game.Leave("p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Phase != PhaseShowdown || game.Players[game.CurrentPlayerIdx].Nickname != "p2" {
+		t.Fatalf("showdown should wait for p2 but got %s", game.Players[game.CurrentPlayerIdx].Nickname)
+	}
+	if result, err = game.Decide("p2", Muck); err != nil {
+		t.Fatal(err)
+	}
+	if result.Phase != PhaseHandComplete || len(result.Winners) != 1 || result.Winners[0].Nickname != "p2" {
+		t.Errorf("p2 should win the pot after the others left but got %s", result.Phase)
+	}
+}
@@ -0,0 +1,168 @@
+package entity
+
+import "sort"
+
+// Pot은 메인팟 또는 사이드팟
+// 올인한 플레이어는 자신이 베팅한 금액만큼만 다른 플레이어들에게서 받을 수 있으므로
+// 올인 금액마다 팟을 나누고 각 팟마다 받을 자격이 있는 플레이어들을 따로 저장함
+type Pot struct {
+	Amount   uint64
+	Eligible []*Player // 이 팟을 받을 수 있는 플레이어들 (죽거나 나가지 않은 플레이어들)
+	Winners  []*Player // AwardPots 이후에 채워짐
+}
+
+// BuildPots는 모든 플레이어의 TotalBet으로 메인팟(0번)과 사이드팟들을 만듬
+//
+// 살아있는 플레이어들의 TotalBet을 작은 순으로 정렬해서 각 금액을 한 단계로 보고
+// 단계마다 모든 플레이어가 그 단계까지 낸 금액을 모아 하나의 팟을 만듬
+// 죽은 플레이어의 칩도 팟에 들어가지만 받을 자격은 없음
+func (g *Game) BuildPots() []Pot {
+	live := g.GetValidPlayers()
+
+	var levels []uint64
+	for _, p := range live {
+		levels = append(levels, p.TotalBet)
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i] < levels[j] })
+
+	var pots []Pot
+	var prevLevel uint64
+	for _, level := range levels {
+		if level == prevLevel {
+			continue
+		}
+
+		pot := Pot{}
+		for _, p := range g.Players {
+			pot.Amount += minBet(p.TotalBet, level) - minBet(p.TotalBet, prevLevel)
+		}
+		for _, p := range live {
+			if p.TotalBet >= level {
+				pot.Eligible = append(pot.Eligible, p)
+			}
+		}
+		pots = append(pots, pot)
+		prevLevel = level
+	}
+
+	// 살아있는 플레이어가 없거나 모두 0을 베팅한 경우에도 죽은 플레이어들의 칩은 팟에 남아야함
+	if len(pots) == 0 {
+		pots = append(pots, Pot{Eligible: live})
+	}
+
+	// 살아있는 플레이어 중 가장 많이 낸 금액보다 더 낸 칩은 마지막 팟에 넣음
+	for _, p := range g.Players {
+		if p.TotalBet > prevLevel {
+			pots[len(pots)-1].Amount += p.TotalBet - prevLevel
+		}
+	}
+	return pots
+}
+
+// AwardPots는 각 팟을 받을 자격이 있는 플레이어 중 가장 높은 핸드에게 나눠줌
+// 나누어 떨어지지 않는 칩은 버튼 왼쪽부터 시계 방향으로 승자들에게 한개씩 줌
+// 플레이어별로 받은 금액을 닉네임을 키로 리턴함
+func (g *Game) AwardPots(pots []Pot) map[string]uint64 {
+	winnings := make(map[string]uint64)
+	seatOrder := g.seatsFrom(g.leftOfButtonIdx())
+
+	for i := range pots {
+		pot := &pots[i]
+		pot.Winners = nil
+
+		for _, p := range seatOrder {
+			if !containsPlayer(pot.Eligible, p) {
+				continue
+			}
+
+			switch {
+			case len(pot.Winners) == 0 || p.HandValue > pot.Winners[0].HandValue:
+				pot.Winners = []*Player{p}
+			case p.HandValue == pot.Winners[0].HandValue:
+				pot.Winners = append(pot.Winners, p)
+			}
+		}
+
+		if len(pot.Winners) == 0 {
+			continue
+		}
+
+		share := pot.Amount / uint64(len(pot.Winners))
+		oddChips := pot.Amount % uint64(len(pot.Winners))
+		for j, p := range pot.Winners {
+			winnings[p.Nickname] += share
+			if uint64(j) < oddChips {
+				winnings[p.Nickname]++
+			}
+		}
+	}
+	return winnings
+}
+
+// Settle은 팟을 만들어 나눠주고 게임에 참여한 플레이어들의 잔고를 정산함
+// 베팅할 때는 잔고를 바로 빼지 않으므로 여기서 받은 금액에서 TotalBet을 뺀 만큼을 반영함
+// 정산된 플레이어들을 승자(받은 금액이 있는 플레이어)와 패자로 나눠서 리턴
+func (g *Game) Settle() (pots []Pot, winners []*Player, losers []*Player) {
+	pots = g.BuildPots()
+	winnings := g.AwardPots(pots)
+
+	for _, p := range g.Players {
+		if !p.IsReady && p.TotalBet == 0 {
+			continue
+		}
+
+		won := winnings[p.Nickname]
+		p.GameBalance = p.GameBalance + won - p.TotalBet
+		p.TotalBalance = p.TotalBalance + won - p.TotalBet
+
+		if won > 0 {
+			winners = append(winners, p)
+		} else {
+			losers = append(losers, p)
+		}
+	}
+	return pots, winners, losers
+}
+
+// IsUncontested는 다른 플레이어가 모두 폴드하거나 나가서 한 명만 남았는지
+// 이 경우 남은 스트릿과 쇼다운 없이 Settle하면 남은 플레이어가 모든 팟을 가져감
+func (g *Game) IsUncontested() bool {
+	return len(g.GetValidPlayers()) == 1
+}
+
+// 버튼 바로 왼쪽 자리의 인덱스
+// 3명 이상이면 SmallBlind이고 헤즈업이면 버튼이 SmallBlind이므로 BigBlind
+// SmallBlind가 dead인 경우에도 BigBlind
+func (g *Game) leftOfButtonIdx() uint {
+	if len(g.GetReadyPlayers()) == 2 || g.IsSmallBlindDead {
+		return g.BigBlindIdx
+	}
+	return g.SmallBlindIdx
+}
+
+// startIdx부터 시계 방향으로 한바퀴 돈 플레이어 순서를 리턴
+func (g *Game) seatsFrom(startIdx uint) []*Player {
+	seats := make([]*Player, 0, len(g.Players))
+	idx := startIdx
+	for i := 0; i < len(g.Players); i++ {
+		seats = append(seats, g.Players[idx])
+		idx = getNextIdx(g.Players, idx)
+	}
+	return seats
+}
+
+func containsPlayer(players []*Player, target *Player) bool {
+	for _, p := range players {
+		if p == target {
+			return true
+		}
+	}
+	return false
+}
+
+func minBet(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
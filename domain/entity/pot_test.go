@@ -0,0 +1,154 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/google/uuid"
+)
+
+type potPlayer struct {
+	totalBet uint64
+	strength uint32 // 클수록 강한 핸드
+	isDead   bool
+}
+
+func newPotGame(players []potPlayer, smallBlindIdx uint) *Game {
+	var game *Game
+	for i, pp := range players {
+		p := NewPlayer(int64(i), fmt.Sprintf("p%d", i), 10000, 1000)
+		p.IsReady = true
+		p.IsDead = pp.isDead
+		p.TotalBet = pp.totalBet
+		p.HandValue = card.HandValue(pp.strength)
+
+		if game == nil {
+			game = NewGame(uuid.New(), 7, p, 10)
+			continue
+		}
+		game.Players = append(game.Players, p)
+	}
+	game.SmallBlindIdx = smallBlindIdx
+	game.BigBlindIdx = getNextIdx(game.Players, smallBlindIdx)
+	return game
+}
+
+func TestSettle(t *testing.T) {
+	tests := []struct {
+		name          string
+		players       []potPlayer
+		smallBlindIdx uint
+		pots          []uint64
+		winnings      []uint64 // 플레이어 순서대로 받아야하는 금액
+	}{
+		{
+			name:     "3-way all-in, short stack wins main pot",
+			players:  []potPlayer{{100, 3, false}, {200, 2, false}, {300, 1, false}},
+			pots:     []uint64{300, 200, 100},
+			winnings: []uint64{300, 200, 100},
+		},
+		{
+			name:     "3-way all-in, losers bet different amounts",
+			players:  []potPlayer{{50, 3, false}, {100, 1, false}, {80, 2, false}},
+			pots:     []uint64{150, 60, 20},
+			winnings: []uint64{150, 20, 60},
+		},
+		{
+			name:          "3-way split, odd chip goes left of the button",
+			players:       []potPlayer{{101, 5, false}, {101, 5, false}, {101, 1, false}},
+			smallBlindIdx: 1,
+			pots:          []uint64{303},
+			winnings:      []uint64{151, 152, 0},
+		},
+		{
+			name:     "folded player's chips go to the pot",
+			players:  []potPlayer{{100, 9, true}, {200, 2, false}, {200, 3, false}},
+			pots:     []uint64{500},
+			winnings: []uint64{0, 0, 500},
+		},
+		{
+			name:     "4-way all-in with folded player betting more than all-in player",
+			players:  []potPlayer{{40, 5, false}, {100, 9, true}, {100, 2, false}, {100, 3, false}},
+			pots:     []uint64{160, 180},
+			winnings: []uint64{160, 0, 0, 180},
+		},
+		{
+			name:     "5-way all-in, every side pot has a different winner",
+			players:  []potPlayer{{10, 5, false}, {20, 4, false}, {30, 3, false}, {40, 2, false}, {50, 1, false}},
+			pots:     []uint64{50, 40, 30, 20, 10},
+			winnings: []uint64{50, 40, 30, 20, 10},
+		},
+		{
+			name: "6-way all-in, ties in every pot",
+			players: []potPlayer{
+				{100, 9, false}, {100, 9, false}, {200, 5, false},
+				{200, 5, false}, {300, 1, false}, {300, 1, false},
+			},
+			pots:     []uint64{600, 400, 200},
+			winnings: []uint64{300, 300, 200, 200, 100, 100},
+		},
+		{
+			name: "7-way all-in, three-way split main pot with odd chip",
+			players: []potPlayer{
+				{34, 5, false}, {34, 5, false}, {34, 5, false}, {50, 4, false},
+				{50, 4, false}, {70, 1, false}, {70, 1, false},
+			},
+			smallBlindIdx: 1,
+			pots:          []uint64{238, 64, 40},
+			winnings:      []uint64{79, 80, 79, 32, 32, 20, 20},
+		},
+		{
+			name: "7-way with folded players",
+			players: []potPlayer{
+				{25, 1, true}, {60, 8, false}, {10, 9, true}, {60, 8, false},
+				{35, 9, false}, {60, 2, false}, {60, 9, true},
+			},
+			smallBlindIdx: 3,
+			pots:          []uint64{210, 100},
+			winnings:      []uint64{0, 50, 0, 50, 210, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		game := newPotGame(tt.players, tt.smallBlindIdx)
+
+		var totalBet, balanceBefore, balanceAfter uint64
+		for _, p := range game.Players {
+			totalBet += p.TotalBet
+			balanceBefore += p.GameBalance
+		}
+
+		pots, winners, losers := game.Settle()
+
+		var potTotal uint64
+		for i, pot := range pots {
+			potTotal += pot.Amount
+			if i >= len(tt.pots) || pot.Amount != tt.pots[i] {
+				t.Errorf("%s: pot #%d should be %v but got %d", tt.name, i, tt.pots, pot.Amount)
+			}
+		}
+		if len(pots) != len(tt.pots) {
+			t.Errorf("%s: expected %d pots but got %d", tt.name, len(tt.pots), len(pots))
+		}
+
+		for i, p := range game.Players {
+			balanceAfter += p.GameBalance
+			won := p.GameBalance + p.TotalBet - 1000
+			if won != tt.winnings[i] {
+				t.Errorf("%s: %s should win %d but got %d", tt.name, p.Nickname, tt.winnings[i], won)
+			}
+		}
+
+		// 칩은 새로 생기거나 사라지면 안됨
+		if potTotal != totalBet {
+			t.Errorf("%s: pots have %d chips but players bet %d", tt.name, potTotal, totalBet)
+		}
+		if balanceAfter != balanceBefore {
+			t.Errorf("%s: total balance changed from %d to %d", tt.name, balanceBefore, balanceAfter)
+		}
+		if len(winners)+len(losers) != len(game.Players) {
+			t.Errorf("%s: every player should be settled", tt.name)
+		}
+	}
+}
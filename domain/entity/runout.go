@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// DefaultRunoutDelay는 올인 런아웃에서 스트릿 사이에 기다리는 시간
+const DefaultRunoutDelay = 2 * time.Second
+
+// WithRunoutDelay는 올인 런아웃에서 남은 스트릿을 한장씩 보여줄 때 스트릿 사이의 간격을 지정함
+// 0이면 기다리지 않고 바로 결과를 보냄
+func WithRunoutDelay(delay time.Duration) GameOption {
+	return func(g *Game) {
+		g.RunoutDelay = delay
+	}
+}
+
+// IsRunout은 두 명 이상 남았는데 칩이 남아서 액션할 수 있는 플레이어가 한 명 이하이고 그 플레이어도 콜할 금액이 없는지
+// 이 경우 더 이상 베팅할 상대가 없으므로 남은 보드를 자동으로 깔고 쇼다운함
+// (헤즈업에서 SmallBlind가 블라인드로 올인되면 BigBlind는 아직 액션하지 않았어도 할 일이 없음)
+func (g *Game) IsRunout() bool {
+	if !g.IsStarted || len(g.GetValidPlayers()) < 2 {
+		return false
+	}
+
+	canActCnt := 0
+	for _, p := range g.Players {
+		if !p.canAct() {
+			continue
+		}
+		// 혼자 남았어도 콜해야하는 금액이 있으면 먼저 콜이나 폴드를 해야함
+		if p.CurrentBet < g.CurrentBet {
+			return false
+		}
+		canActCnt++
+	}
+	return canActCnt <= 1
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+)
+
+func TestIsRunout(t *testing.T) {
+	tests := []struct {
+		name     string
+		balances []uint64
+		steps    []actionStep
+		isRunout bool
+	}{
+		{
+			name:     "heads-up all-in and call",
+			balances: []uint64{1000, 300},
+			steps: []actionStep{
+				{"p0", Action{Type: AllIn}, nil},
+				{"p1", Action{Type: Call}, gameerror.OverBalance},
+				{"p1", Action{Type: AllIn}, nil},
+			},
+			isRunout: true,
+		},
+		{
+			name:     "only one player has chips behind",
+			balances: []uint64{1000, 1000, 100},
+			steps: []actionStep{
+				{"p2", Action{Type: AllIn}, nil},
+				{"p0", Action{Type: Fold}, nil},
+				{"p1", Action{Type: Call}, nil},
+			},
+			isRunout: true,
+		},
+		{
+			name:     "two players can still bet",
+			balances: []uint64{1000, 1000, 100},
+			steps: []actionStep{
+				{"p2", Action{Type: AllIn}, nil},
+				{"p0", Action{Type: Call}, nil},
+				{"p1", Action{Type: Call}, nil},
+			},
+			isRunout: false,
+		},
+	}
+
+	for _, tt := range tests {
+		game := newActionGame(tt.balances...)
+
+		var result *ActionResult
+		for _, step := range tt.steps {
+			res, err := game.HandleAction(step.player, step.action)
+			if err != step.err {
+				t.Fatalf("%s: %s %s: expected %v but got %v", tt.name, step.player, step.action.Type, step.err, err)
+			}
+			if err == nil {
+				result = res
+			}
+		}
+
+		if !result.IsRoundEnd || result.IsRunout != tt.isRunout || game.IsRunout() != tt.isRunout {
+			t.Errorf("%s: expected runout %v but got %v", tt.name, tt.isRunout, result.IsRunout)
+		}
+	}
+}
+
+func TestDealNextStreet(t *testing.T) {
+	game := newActionGame(1000, 1000)
+
+	streets := []struct {
+		phase    Phase
+		boardLen int
+	}{
+		{PhaseFlop, 3},
+		{PhaseTurn, 4},
+		{PhaseRiver, 5},
+	}
+	for _, street := range streets {
+		if err := game.DealNextStreet(); err != nil {
+			t.Fatal(err)
+		}
+		if game.Phase != street.phase || len(game.Board) != street.boardLen {
+			t.Errorf("expected %s with %d cards but got %s with %d cards", street.phase, street.boardLen, game.Phase, len(game.Board))
+		}
+	}
+
+	if err := game.DealNextStreet(); err != gameerror.InvalidBoardStreet {
+		t.Errorf("expected %v after river but got %v", gameerror.InvalidBoardStreet, err)
+	}
+}
@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+)
+
+const (
+	DefaultActionTimeout = 30 * time.Second // 한 액션마다 주어지는 시간
+	DefaultTimeBank      = 60 * time.Second // 플레이어마다 핸드와 상관없이 추가로 쓸 수 있는 시간
+)
+
+// WithActionTimeout은 한 액션마다 주어지는 시간을 지정함 (0이면 시간 제한 없음)
+func WithActionTimeout(timeout time.Duration) GameOption {
+	return func(g *Game) {
+		g.ActionTimeout = timeout
+	}
+}
+
+// WithTimeBank는 방에 들어오는 플레이어마다 주어지는 타임뱅크를 지정함
+func WithTimeBank(timeBank time.Duration) GameOption {
+	return func(g *Game) {
+		g.TimeBankSize = timeBank
+	}
+}
+
+// WithClock은 액션 마감 시간을 계산할 때 사용할 현재 시간을 지정함 (테스트용)
+// redis에 저장되지 않으므로 다시 불러온 게임은 항상 time.Now를 사용함
+func WithClock(now func() time.Time) GameOption {
+	return func(g *Game) {
+		g.clock = now
+	}
+}
+
+func (g *Game) now() time.Time {
+	if g.clock != nil {
+		return g.clock()
+	}
+	return time.Now()
+}
+
+// freezeClock은 명령 하나를 처리하는 동안 now가 처음 읽은 시간을 계속 리턴하게 하고 원래 시계로 되돌리는 함수를 리턴함
+// 이벤트에 기록되는 시간과 마감 시간을 같은 시간으로 계산해야 이벤트로 다시 만든 게임의 마감 시간이 같아짐
+func (g *Game) freezeClock() func() {
+	return g.freezeClockAt(g.now())
+}
+
+func (g *Game) freezeClockAt(now time.Time) func() {
+	clock := g.clock
+	g.clock = func() time.Time { return now }
+	return func() { g.clock = clock }
+}
+
+// startActionClock은 현재 플레이어의 액션 마감 시간을 정함
+// 마감 시간은 게임과 함께 저장되므로 서버가 재시작되어도 같은 마감 시간으로 타이머를 다시 시작할 수 있음
+func (g *Game) startActionClock() {
+	if g.ActionTimeout == 0 || !g.IsStarted || !g.Players[g.CurrentPlayerIdx].canAct() {
+		g.stopActionClock()
+		return
+	}
+	g.ActionDeadline = g.now().Add(g.ActionTimeout)
+}
+
+func (g *Game) stopActionClock() {
+	g.ActionDeadline = time.Time{}
+}
+
+// TimeRemaining은 현재 플레이어가 액션하기까지 남은 시간 (타이머가 없거나 지났으면 0)
+func (g *Game) TimeRemaining() time.Duration {
+	if g.ActionDeadline.IsZero() {
+		return 0
+	}
+	if remaining := g.ActionDeadline.Sub(g.now()); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// ExtendTimeBank는 현재 플레이어의 타임뱅크에서 amount만큼 꺼내 액션 마감 시간을 늘림
+// amount가 0이거나 남은 타임뱅크보다 많으면 남은 타임뱅크를 모두 사용함
+func (g *Game) ExtendTimeBank(nickname string, amount time.Duration) (time.Time, error) {
+	defer g.freezeClock()()
+
+	p, err := g.validateTurn(nickname)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if g.ActionDeadline.IsZero() {
+		return time.Time{}, gameerror.NoActionClock
+	}
+	if p.TimeBank == 0 {
+		return time.Time{}, gameerror.TimeBankEmpty
+	}
+
+	if amount == 0 || amount > p.TimeBank {
+		amount = p.TimeBank
+	}
+	g.useTimeBank(p, amount)
+	g.record(Event{Type: TimeBankUsed, Nickname: nickname, TimeBank: amount})
+	return g.ActionDeadline, nil
+}
+
+func (g *Game) useTimeBank(p *Player, amount time.Duration) {
+	p.TimeBank -= amount
+	g.ActionDeadline = g.ActionDeadline.Add(amount)
+}
+
+// TimeoutAction은 마감 시간이 지난 현재 플레이어 대신 할 액션을 리턴함
+// 체크할 수 있으면 체크하고 콜해야하는 금액이 있으면 폴드함
+func (g *Game) TimeoutAction() (string, Action, error) {
+	if !g.IsStarted || !g.Phase.IsBetting() || g.ActionDeadline.IsZero() {
+		return "", Action{}, gameerror.NoActionClock
+	}
+	if g.now().Before(g.ActionDeadline) {
+		return "", Action{}, gameerror.ActionNotExpired
+	}
+
+	p := g.Players[g.CurrentPlayerIdx]
+	if g.amountToCall(p) == 0 {
+		return p.Nickname, Action{Type: Check}, nil
+	}
+	return p.Nickname, Action{Type: Fold}, nil
+}
+
+// TimeoutDecision은 쇼다운에서 마감 시간이 지나도록 결정하지 않은 현재 플레이어 대신 할 결정을 리턴함
+// AutoMuck처럼 핸드를 버림
+func (g *Game) TimeoutDecision() (string, ShowdownDecision, error) {
+	if g.Phase != PhaseShowdown || g.ActionDeadline.IsZero() {
+		return "", "", gameerror.NoActionClock
+	}
+	if g.now().Before(g.ActionDeadline) {
+		return "", "", gameerror.ActionNotExpired
+	}
+	return g.Players[g.CurrentPlayerIdx].Nickname, Muck, nil
+}
@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/google/uuid"
+)
+
+type fakeClock struct {
+	now  time.Time
+	tick time.Duration // 0이 아니면 시간을 읽을 때마다 tick만큼 흐름
+}
+
+func (c *fakeClock) Now() time.Time {
+	now := c.now
+	c.now = c.now.Add(c.tick)
+	return now
+}
+
+func newClockGame(clock *fakeClock, opts ...GameOption) *Game {
+	opts = append(opts, WithClock(clock.Now))
+	host := NewPlayer(0, "p0", 10000, 1000)
+	host.IsReady = true
+	game := NewGame(uuid.New(), 7, host, 10, opts...)
+	for i := 1; i < 3; i++ {
+		p := NewPlayer(int64(i), fmt.Sprintf("p%d", i), 10000, 1000)
+		p.IsReady = true
+		if err := game.AddPlayer(p); err != nil {
+			panic(err)
+		}
+	}
+	if err := game.StartGame(); err != nil {
+		panic(err)
+	}
+	return game
+}
+
+func TestActionClock(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	game := newClockGame(clock, WithActionTimeout(10*time.Second))
+
+	if !game.ActionDeadline.Equal(clock.now.Add(10 * time.Second)) {
+		t.Errorf("first player's deadline should be 10 seconds later but got %v", game.ActionDeadline)
+	}
+
+	// 다음 플레이어는 액션한 시점부터 다시 10초
+	clock.now = clock.now.Add(4 * time.Second)
+	if _, err := game.HandleAction("p2", Action{Type: Call}); err != nil {
+		t.Fatal(err)
+	}
+	if !game.ActionDeadline.Equal(clock.now.Add(10 * time.Second)) {
+		t.Errorf("next player's deadline should restart but got %v", game.ActionDeadline)
+	}
+	if game.TimeRemaining() != 10*time.Second {
+		t.Errorf("expected 10s remaining but got %v", game.TimeRemaining())
+	}
+
+	if _, err := game.HandleAction("p0", Action{Type: Call}); err != nil {
+		t.Fatal(err)
+	}
+	result, err := game.HandleAction("p1", Action{Type: Check})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.IsRoundEnd || !game.ActionDeadline.IsZero() {
+		t.Error("clock should stop when betting round ends")
+	}
+
+	game.ResetBettingRound()
+	if !game.ActionDeadline.Equal(clock.now.Add(10 * time.Second)) {
+		t.Errorf("clock should restart on the next street but got %v", game.ActionDeadline)
+	}
+
+	game.InitGame()
+	if !game.ActionDeadline.IsZero() {
+		t.Error("clock should stop when hand ends")
+	}
+}
+
+func TestTimeoutAction(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	game := newClockGame(clock, WithActionTimeout(10*time.Second))
+
+	if _, _, err := game.TimeoutAction(); err != gameerror.ActionNotExpired {
+		t.Errorf("expected %v but got %v", gameerror.ActionNotExpired, err)
+	}
+
+	// 콜해야하는 금액이 있으면 폴드
+	clock.now = clock.now.Add(10 * time.Second)
+	nickname, action, err := game.TimeoutAction()
+	if err != nil || nickname != "p2" || action.Type != Fold {
+		t.Errorf("expected p2 to fold but got %s %s (%v)", nickname, action.Type, err)
+	}
+	if _, err := game.HandleAction(nickname, action); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := game.HandleAction("p0", Action{Type: Call}); err != nil {
+		t.Fatal(err)
+	}
+
+	// BigBlind는 체크할 수 있으므로 체크
+	clock.now = clock.now.Add(11 * time.Second)
+	nickname, action, err = game.TimeoutAction()
+	if err != nil || nickname != "p1" || action.Type != Check {
+		t.Errorf("expected p1 to check but got %s %s (%v)", nickname, action.Type, err)
+	}
+
+	noClock := newClockGame(clock, WithActionTimeout(0))
+	if !noClock.ActionDeadline.IsZero() {
+		t.Error("there should be no deadline without action timeout")
+	}
+	if _, _, err := noClock.TimeoutAction(); err != gameerror.NoActionClock {
+		t.Errorf("expected %v but got %v", gameerror.NoActionClock, err)
+	}
+}
+
+func TestExtendTimeBank(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	game := newClockGame(clock, WithActionTimeout(10*time.Second), WithTimeBank(30*time.Second))
+	deadline := game.ActionDeadline
+
+	tests := []struct {
+		name     string
+		player   string
+		amount   time.Duration
+		deadline time.Time
+		timeBank time.Duration
+		err      error
+	}{
+		{"not player's turn", "p0", 10 * time.Second, time.Time{}, 30 * time.Second, gameerror.InvalidPlayerTurn},
+		{"part of time bank", "p2", 20 * time.Second, deadline.Add(20 * time.Second), 10 * time.Second, nil},
+		{"more than time bank left", "p2", 20 * time.Second, deadline.Add(30 * time.Second), 0, nil},
+		{"empty time bank", "p2", 10 * time.Second, time.Time{}, 0, gameerror.TimeBankEmpty},
+	}
+
+	for _, tt := range tests {
+		newDeadline, err := game.ExtendTimeBank(tt.player, tt.amount)
+		if err != tt.err || !newDeadline.Equal(tt.deadline) {
+			t.Errorf("%s: expected %v (%v) but got %v (%v)", tt.name, tt.deadline, tt.err, newDeadline, err)
+		}
+		if timeBank := game.FindPlayer("p2").TimeBank; timeBank != tt.timeBank {
+			t.Errorf("%s: expected time bank %v but got %v", tt.name, tt.timeBank, timeBank)
+		}
+	}
+
+	if game.FindPlayer("p0").TimeBank != 30*time.Second {
+		t.Error("other players' time bank should not change")
+	}
+}
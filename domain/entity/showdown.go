@@ -0,0 +1,154 @@
+package entity
+
+import "github.com/PudgeKim/go-holdem/errors/gameerror"
+
+// ShowdownDecision은 쇼다운에서 플레이어가 핸드를 공개할지 버릴지
+type ShowdownDecision string
+
+const (
+	Show ShowdownDecision = "Show"
+	Muck ShowdownDecision = "Muck"
+)
+
+// ShowdownReveal은 쇼다운에서 한 플레이어의 차례와 결정
+type ShowdownReveal struct {
+	Nickname string
+	Decision ShowdownDecision
+	IsForced bool // 규칙상 반드시 공개해야했는지 (첫 공개, 올인 쇼다운, 이길 수 있는 핸드)
+}
+
+// startShowdown은 리버까지 베팅이 끝난 후 남은 플레이어들의 핸드를 평가하고 공개를 시작함
+// 모든 플레이어의 공개가 끝났으면 true를 리턴하고, 버릴 수 있는 플레이어의 결정을 기다리면 false를 리턴함
+func (g *Game) startShowdown() (bool, error) {
+	if err := g.transition(PhaseShowdown); err != nil {
+		return false, err
+	}
+	if err := g.EvaluateHands(); err != nil {
+		return false, err
+	}
+	g.Reveals = nil
+	return g.advanceShowdown(), nil
+}
+
+// advanceShowdown은 공개 순서대로 반드시 공개해야하는 핸드는 공개하고 AutoMuck인 플레이어의 핸드는 버림
+// AutoMuck을 끈 플레이어가 버릴 수 있는 차례가 되면 그 플레이어를 현재 플레이어로 하고 Decide를 기다림
+//
+// 마지막 스트릿에서 마지막으로 베팅하거나 레이즈한 플레이어가 먼저 공개하고
+// 아무도 베팅하지 않았으면 버튼 왼쪽의 첫 플레이어부터 시계 방향으로 공개함
+// 처음 공개하는 플레이어와 지금까지 공개된 핸드보다 강하거나 같은 핸드는 반드시 공개해야하고 약한 핸드만 버릴 수 있음
+// 버린 핸드는 공개된 핸드보다 약하므로 팟을 나눌 때 결과가 달라지지 않음
+func (g *Game) advanceShowdown() bool {
+	for _, p := range g.showdownOrder() {
+		// 쇼다운 도중에 나간 플레이어는 순서에서 빠지므로 이미 공개하거나 버린 플레이어를 건너뛰며 찾음
+		if g.hasRevealed(p) {
+			continue
+		}
+		switch {
+		case g.mustShow(p):
+			g.Reveals = append(g.Reveals, ShowdownReveal{Nickname: p.Nickname, Decision: Show, IsForced: true})
+		case p.AutoMuck:
+			g.Reveals = append(g.Reveals, ShowdownReveal{Nickname: p.Nickname, Decision: Muck})
+		default:
+			g.CurrentPlayerIdx, _ = g.seatIdx(int(p.Seat))
+			g.startActionClock()
+			return false
+		}
+	}
+	g.stopActionClock()
+	return true
+}
+
+func (g *Game) hasRevealed(p *Player) bool {
+	for _, reveal := range g.Reveals {
+		if reveal.Nickname == p.Nickname {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Game) showdownOrder() []*Player {
+	live := g.GetValidPlayers()
+	startIdx := g.leftOfButtonIdx()
+	if idx, ok := g.seatIdx(g.AggressorSeat); ok && containsPlayer(live, g.Players[idx]) {
+		startIdx = idx
+	}
+
+	var order []*Player
+	for _, p := range g.seatsFrom(startIdx) {
+		if containsPlayer(live, p) {
+			order = append(order, p)
+		}
+	}
+	return order
+}
+
+// mustShow는 p가 핸드를 버릴 수 없는지 확인함
+//
+// 남은 플레이어 중 누군가 올인했으면 모두 공개해야함
+// (TDA 규칙: 올인한 플레이어가 있고 모든 베팅이 끝났으면 남은 핸드를 모두 공개함)
+// 올인 런아웃은 항상 이 경우이므로 런아웃 중에는 플레이어의 결정을 기다리지 않음
+func (g *Game) mustShow(p *Player) bool {
+	var best *Player
+	for _, reveal := range g.Reveals {
+		if shown := g.FindPlayer(reveal.Nickname); reveal.Decision == Show && (best == nil || shown.HandValue > best.HandValue) {
+			best = shown
+		}
+	}
+	if best == nil || p.HandValue >= best.HandValue {
+		return true
+	}
+
+	for _, live := range g.GetValidPlayers() {
+		if live.IsAllIn {
+			return true
+		}
+	}
+	return false
+}
+
+// Decide는 쇼다운에서 핸드를 버릴 수 있는 현재 플레이어가 공개할지 버릴지 정함
+// 남은 플레이어들의 공개가 모두 끝나면 정산하고 Phase는 HandComplete가 됨
+// Apply처럼 처리하기 전에 SetMemento로 스냅샷을 찍어두므로 이후의 처리가 실패하면 Undo로 되돌릴 수 있음
+func (g *Game) Decide(nickname string, decision ShowdownDecision) (*ApplyResult, error) {
+	if g.Phase != PhaseShowdown {
+		return nil, gameerror.NotShowdown
+	}
+	defer g.freezeClock()()
+
+	p := g.FindPlayer(nickname)
+	if p == nil {
+		return nil, gameerror.NoPlayerExists
+	}
+	if g.Players[g.CurrentPlayerIdx] != p {
+		return nil, gameerror.InvalidPlayerTurn
+	}
+	if decision != Show && decision != Muck {
+		return nil, gameerror.InvalidShowdownDecision
+	}
+
+	g.SetMemento()
+	g.record(Event{Type: ShowdownDecided, Nickname: nickname, Decision: decision})
+	result := &ApplyResult{}
+	if err := g.continueShowdown(g.decide(p, decision), result); err != nil {
+		g.Undo()
+		return nil, err
+	}
+	result.Phase = g.Phase
+	return result, nil
+}
+
+// decide는 p의 결정을 남기고 다음 플레이어로 넘김 (모두 공개했으면 true)
+func (g *Game) decide(p *Player, decision ShowdownDecision) bool {
+	g.Reveals = append(g.Reveals, ShowdownReveal{Nickname: p.Nickname, Decision: decision})
+	return g.advanceShowdown()
+}
+
+// continueShowdown은 지금까지의 공개를 result에 담고 모두 공개했으면 정산함
+func (g *Game) continueShowdown(isDone bool, result *ApplyResult) error {
+	result.Reveals = append([]ShowdownReveal(nil), g.Reveals...)
+	if !isDone {
+		return nil
+	}
+	return g.completeHand(result)
+}
@@ -0,0 +1,242 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+)
+
+// 프리플랍에서 모두 콜하고 리버 베팅까지 진행한 게임을 리턴함 (river가 nil이면 프리플랍 베팅이 끝난 상태, 올인 런아웃)
+// p0는 AA, p1은 QQ, p2는 45s를 들고 있고 보드는 2c 3d 8h Js Kc
+func newShowdownGame(t *testing.T, balances []uint64, preflop, river []actionStep, showAll []string, opts ...GameOption) *Game {
+	game := newActionGame(balances...)
+	for _, opt := range opts {
+		opt(game)
+	}
+	game.FindPlayer("p0").Hands = mustParseCards("Ah Ad")
+	game.FindPlayer("p1").Hands = mustParseCards("Qh Qd")
+	game.FindPlayer("p2").Hands = mustParseCards("4s 5s")
+	for _, nickname := range showAll {
+		game.FindPlayer(nickname).AutoMuck = false
+	}
+
+	if preflop == nil {
+		preflop = []actionStep{
+			{"p2", Action{Type: Call}, nil},
+			{"p0", Action{Type: Call}, nil},
+			{"p1", Action{Type: Check}, nil},
+		}
+	}
+	steps := preflop
+	if river != nil {
+		steps = append(steps, river...)
+	}
+
+	for i, step := range steps {
+		if river != nil && i == len(preflop) {
+			// 플랍과 턴은 생략하고 리버 베팅만 진행함
+			game.ResetBettingRound()
+		}
+		if _, err := game.HandleAction(step.player, step.action); err != step.err {
+			t.Fatalf("%s %s: expected %v but got %v", step.player, step.action.Type, step.err, err)
+		}
+	}
+	game.Board = mustParseCards("2c 3d 8h Js Kc")
+	game.Phase = PhaseRiver
+	return game
+}
+
+func TestShowdown(t *testing.T) {
+	type reveal struct {
+		player   string
+		decision ShowdownDecision
+		isForced bool
+	}
+
+	betOnRiver := []actionStep{
+		{"p0", Action{Type: Check}, nil},
+		{"p1", Action{Type: Bet, Amount: 50}, nil},
+		{"p2", Action{Type: Call}, nil},
+		{"p0", Action{Type: Call}, nil},
+	}
+	checkOnRiver := []actionStep{
+		{"p0", Action{Type: Check}, nil},
+		{"p1", Action{Type: Check}, nil},
+		{"p2", Action{Type: Check}, nil},
+	}
+
+	tests := []struct {
+		name      string
+		balances  []uint64
+		preflop   []actionStep
+		river     []actionStep
+		showAll   []string // AutoMuck을 끈 플레이어들
+		decisions []reveal // 결정을 기다리는 플레이어 순서대로 Decide로 보낼 결정
+		reveals   []reveal
+	}{
+		{
+			name:     "last aggressor shows first and losing hands are mucked",
+			balances: []uint64{1000, 1000, 1000},
+			river:    betOnRiver,
+			reveals:  []reveal{{"p1", Show, true}, {"p2", Muck, false}, {"p0", Show, true}},
+		},
+		{
+			name:     "first player left of the button shows first without a bet",
+			balances: []uint64{1000, 1000, 1000},
+			river:    checkOnRiver,
+			reveals:  []reveal{{"p0", Show, true}, {"p1", Muck, false}, {"p2", Muck, false}},
+		},
+		{
+			name:      "player without auto muck decides to show losing hand",
+			balances:  []uint64{1000, 1000, 1000},
+			river:     betOnRiver,
+			showAll:   []string{"p2"},
+			decisions: []reveal{{"p2", Show, false}},
+			reveals:   []reveal{{"p1", Show, true}, {"p2", Show, false}, {"p0", Show, true}},
+		},
+		{
+			name:      "player without auto muck decides to muck",
+			balances:  []uint64{1000, 1000, 1000},
+			river:     betOnRiver,
+			showAll:   []string{"p2"},
+			decisions: []reveal{{"p2", Muck, false}},
+			reveals:   []reveal{{"p1", Show, true}, {"p2", Muck, false}, {"p0", Show, true}},
+		},
+		{
+			name:      "only players who may muck are asked",
+			balances:  []uint64{1000, 1000, 1000},
+			river:     checkOnRiver,
+			showAll:   []string{"p0", "p1", "p2"},
+			decisions: []reveal{{"p1", Show, false}, {"p2", Muck, false}},
+			reveals:   []reveal{{"p0", Show, true}, {"p1", Show, false}, {"p2", Muck, false}},
+		},
+		{
+			name:     "all hands are shown when someone is all-in",
+			balances: []uint64{1000, 1000, 100},
+			preflop: []actionStep{
+				{"p2", Action{Type: AllIn}, nil},
+				{"p0", Action{Type: Call}, nil},
+				{"p1", Action{Type: Call}, nil},
+			},
+			showAll: []string{"p0", "p1"},
+			reveals: []reveal{{"p2", Show, true}, {"p0", Show, true}, {"p1", Show, true}},
+		},
+	}
+
+	for _, tt := range tests {
+		game := newShowdownGame(t, tt.balances, tt.preflop, tt.river, tt.showAll)
+
+		result := &ApplyResult{}
+		if err := game.showdown(result); err != nil {
+			t.Fatalf("%s: %s", tt.name, err.Error())
+		}
+		for _, d := range tt.decisions {
+			if game.Phase != PhaseShowdown || game.Players[game.CurrentPlayerIdx].Nickname != d.player {
+				t.Fatalf("%s: showdown should wait for %s but got %s (%s)", tt.name, d.player,
+					game.Players[game.CurrentPlayerIdx].Nickname, game.Phase)
+			}
+			var err error
+			if result, err = game.Decide(d.player, d.decision); err != nil {
+				t.Fatalf("%s: %s %s: %s", tt.name, d.player, d.decision, err.Error())
+			}
+		}
+
+		if game.Phase != PhaseHandComplete || len(result.Pots) == 0 {
+			t.Errorf("%s: hand should be settled after the showdown but got %s", tt.name, game.Phase)
+		}
+		if len(result.Reveals) != len(tt.reveals) {
+			t.Fatalf("%s: expected %d reveals but got %d", tt.name, len(tt.reveals), len(result.Reveals))
+		}
+		for i, r := range result.Reveals {
+			expected := tt.reveals[i]
+			if r.Nickname != expected.player || r.Decision != expected.decision || r.IsForced != expected.isForced {
+				t.Errorf("%s: reveal %d: expected %s %s (forced %v) but got %s %s (forced %v)", tt.name, i,
+					expected.player, expected.decision, expected.isForced, r.Nickname, r.Decision, r.IsForced)
+			}
+		}
+	}
+}
+
+func TestDecide(t *testing.T) {
+	game := newShowdownGame(t, []uint64{1000, 1000, 1000}, nil, nil, []string{"p1", "p2"})
+	if _, err := game.Decide("p1", Show); err != gameerror.NotShowdown {
+		t.Errorf("deciding before the showdown should return %v but got %v", gameerror.NotShowdown, err)
+	}
+
+	result := &ApplyResult{}
+	if err := game.showdown(result); err != nil {
+		t.Fatal(err)
+	}
+	if game.Phase != PhaseShowdown || len(result.Reveals) != 1 || len(result.Pots) != 0 {
+		t.Fatalf("showdown should wait for p1 after p0 shows but got %s with %d reveals", game.Phase, len(result.Reveals))
+	}
+
+	tests := []struct {
+		name     string
+		player   string
+		decision ShowdownDecision
+		err      error
+	}{
+		{"unknown player", "nobody", Show, gameerror.NoPlayerExists},
+		{"not player's turn", "p2", Show, gameerror.InvalidPlayerTurn},
+		{"invalid decision", "p1", ShowdownDecision("Fold"), gameerror.InvalidShowdownDecision},
+	}
+	for _, tt := range tests {
+		if _, err := game.Decide(tt.player, tt.decision); err != tt.err {
+			t.Errorf("%s: expected %v but got %v", tt.name, tt.err, err)
+		}
+	}
+
+	result, err := game.Decide("p1", Muck)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Phase != PhaseShowdown || game.Players[game.CurrentPlayerIdx].Nickname != "p2" || len(result.Reveals) != 2 {
+		t.Fatalf("showdown should wait for p2 after p1 mucks but got %s with %d reveals", result.Phase, len(result.Reveals))
+	}
+
+	// 결정한 후의 처리가 실패하면 결정 전으로 되돌릴 수 있음
+	if _, err := game.Decide("p2", Muck); err != nil {
+		t.Fatal(err)
+	}
+	game.Undo()
+	if game.Phase != PhaseShowdown || len(game.Reveals) != 2 || game.Players[game.CurrentPlayerIdx].Nickname != "p2" {
+		t.Errorf("undo should restore the showdown waiting for p2 but got %s with %d reveals", game.Phase, len(game.Reveals))
+	}
+}
+
+func TestTimeoutDecision(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	game := newShowdownGame(t, []uint64{1000, 1000, 1000}, nil, nil, []string{"p1"},
+		WithClock(clock.Now), WithActionTimeout(10*time.Second))
+
+	if _, _, err := game.TimeoutDecision(); err != gameerror.NoActionClock {
+		t.Errorf("expected %v before the showdown but got %v", gameerror.NoActionClock, err)
+	}
+	if err := game.showdown(&ApplyResult{}); err != nil {
+		t.Fatal(err)
+	}
+	if !game.ActionDeadline.Equal(clock.now.Add(10 * time.Second)) {
+		t.Errorf("player who may muck should get the action timeout but got %v", game.ActionDeadline)
+	}
+	if _, _, err := game.TimeoutAction(); err != gameerror.NoActionClock {
+		t.Errorf("there should be no betting action during the showdown but got %v", err)
+	}
+	if _, _, err := game.TimeoutDecision(); err != gameerror.ActionNotExpired {
+		t.Errorf("expected %v but got %v", gameerror.ActionNotExpired, err)
+	}
+
+	clock.now = clock.now.Add(10 * time.Second)
+	nickname, decision, err := game.TimeoutDecision()
+	if err != nil || nickname != "p1" || decision != Muck {
+		t.Fatalf("expected p1 to muck but got %s %s (%v)", nickname, decision, err)
+	}
+	result, err := game.Decide(nickname, decision)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Phase != PhaseHandComplete || !game.ActionDeadline.IsZero() {
+		t.Errorf("hand should be settled after the timeout but got %s", result.Phase)
+	}
+}
@@ -0,0 +1,8 @@
+package repository
+
+import "context"
+
+// EventPublisher는 게임 진행 중에 생기는 이벤트(타이머 시작, 남은 시간 등)를 방에 있는 클라이언트들에게 보냄
+type EventPublisher interface {
+	Publish(ctx context.Context, subscribeChan string, event interface{}) error
+}
@@ -9,8 +9,16 @@ import (
 type GameRepository interface {
 	GetGame(ctx context.Context, roomId string) (*entity.Game, error)
 	SaveGame(ctx context.Context, roomId string, game *entity.Game) error
-	CreateGame(ctx context.Context, hostPlayer *entity.Player, minBetAmount uint64) (game *entity.Game, roomId string, err error)
+	CreateGame(ctx context.Context, hostPlayer *entity.Player, minBetAmount uint64, opts ...entity.GameOption) (game *entity.Game, roomId string, err error)
 	DeleteGame(ctx context.Context, roomId string) error 
 	FindPlayer(ctx context.Context, roomId string, nickname string) (*entity.Player, error)
-	AddPlayer(ctx context.Context, roomId string, player *entity.Player) error
-}
\ No newline at end of file
+}
+
+// GameEventRepository는 방마다 게임의 이벤트 로그를 순서대로 저장함
+// 저장된 게임이 없어져도 entity.ReplayGame으로 이벤트들을 다시 적용해서 복구할 수 있음
+type GameEventRepository interface {
+	// 로그의 마지막 Seq가 events[0].Seq 바로 전일 때만 events를 한번에 추가하고 아니면 gameerror.StaleGame을 리턴함
+	AppendEvents(ctx context.Context, roomId string, events ...entity.Event) error
+	GetEvents(ctx context.Context, roomId string, fromSeq uint64) ([]entity.Event, error) // Seq가 fromSeq 이상인 이벤트들
+	DeleteEvents(ctx context.Context, roomId string) error
+}
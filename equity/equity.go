@@ -0,0 +1,247 @@
+package equity
+
+import (
+	"math/rand"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+	"github.com/PudgeKim/go-holdem/errors/equityerror"
+)
+
+const (
+	// 남은 보드 조합 수가 이 값 이하이면 모든 경우를 계산함
+	// (헤즈업 프리플랍은 1,712,304개라 몬테카를로로, 플랍 이후는 전부 전수 조사로 계산됨)
+	DefaultMaxExhaustive = 100000
+	DefaultIterations    = 20000
+)
+
+type Mode string
+
+const (
+	Exhaustive Mode = "Exhaustive"
+	MonteCarlo Mode = "MonteCarlo"
+)
+
+type Options struct {
+	Board         []card.Card // 이미 깔린 보드 카드 (0~5장)
+	Dead          []card.Card // 죽은 플레이어의 카드 등 덱에서 빠진 카드
+	Iterations    int         // 몬테카를로 반복 횟수 (0이면 DefaultIterations, 음수면 InvalidIterations)
+	Seed          int64       // 몬테카를로에서 사용할 시드 (같은 시드면 항상 같은 결과)
+	MaxExhaustive int         // 0이면 DefaultMaxExhaustive
+}
+
+// 모든 값은 퍼센트(0~100)
+// Equity는 이긴 경우 1, n명이 비긴 경우 1/n을 받는다고 했을 때의 기대 지분
+type PlayerEquity struct {
+	Win    float64 `json:"win"`
+	Tie    float64 `json:"tie"`
+	Equity float64 `json:"equity"`
+}
+
+type Result struct {
+	Players []PlayerEquity `json:"players"` // hands와 같은 순서
+	Mode    Mode           `json:"mode"`
+	Samples int            `json:"samples"` // 계산에 사용된 보드의 수
+}
+
+// Calculate는 각 플레이어의 홀카드와 보드를 받아 승률/무승부율/에퀴티를 계산함
+// 남은 보드의 경우의 수가 적으면 전수 조사를, 많으면 몬테카를로 시뮬레이션을 사용함
+func Calculate(hands [][]card.Card, opts Options) (*Result, error) {
+	if len(hands) < 2 {
+		return nil, equityerror.NotEnoughPlayers
+	}
+	if len(opts.Board) > 5 {
+		return nil, equityerror.InvalidBoard
+	}
+	if opts.Iterations < 0 {
+		return nil, equityerror.InvalidIterations
+	}
+
+	used := make(map[card.Card]bool)
+	markUsed := func(cards []card.Card) error {
+		for _, c := range cards {
+			if !c.IsValid() {
+				return carderror.InvalidCard
+			}
+			if used[c] {
+				return carderror.DuplicateCard
+			}
+			used[c] = true
+		}
+		return nil
+	}
+
+	for _, hand := range hands {
+		if len(hand) != 2 {
+			return nil, equityerror.InvalidHoleCards
+		}
+		if err := markUsed(hand); err != nil {
+			return nil, err
+		}
+	}
+	if err := markUsed(opts.Board); err != nil {
+		return nil, err
+	}
+	if err := markUsed(opts.Dead); err != nil {
+		return nil, err
+	}
+
+	var remaining []card.Card
+	for _, c := range fullDeck() {
+		if !used[c] {
+			remaining = append(remaining, c)
+		}
+	}
+
+	need := 5 - len(opts.Board)
+	if need > len(remaining) {
+		return nil, equityerror.NotEnoughCards
+	}
+
+	maxExhaustive := opts.MaxExhaustive
+	if maxExhaustive == 0 {
+		maxExhaustive = DefaultMaxExhaustive
+	}
+
+	c := newCalculator(hands, opts.Board)
+
+	if combinations(len(remaining), need) <= maxExhaustive {
+		c.enumerate(remaining, need, 0)
+		return c.result(Exhaustive), nil
+	}
+
+	iterations := opts.Iterations
+	if iterations == 0 {
+		iterations = DefaultIterations
+	}
+	c.simulate(remaining, need, iterations, rand.New(rand.NewSource(opts.Seed)))
+	return c.result(MonteCarlo), nil
+}
+
+type calculator struct {
+	hands     [][]card.Card
+	boardSize int         // 원래 주어진 보드 카드 수
+	board     []card.Card // 원래 보드 + 이번 샘플에서 채운 카드 (항상 5장)
+	hand      []card.Card // 평가용 7장 버퍼
+	values    []card.HandValue
+
+	wins    []float64
+	ties    []float64
+	equity  []float64
+	samples int
+}
+
+func newCalculator(hands [][]card.Card, board []card.Card) *calculator {
+	c := &calculator{
+		hands:     hands,
+		boardSize: len(board),
+		board:     make([]card.Card, 5),
+		hand:      make([]card.Card, 7),
+		values:    make([]card.HandValue, len(hands)),
+		wins:      make([]float64, len(hands)),
+		ties:      make([]float64, len(hands)),
+		equity:    make([]float64, len(hands)),
+	}
+	copy(c.board, board)
+	return c
+}
+
+// 남은 카드들 중 need장을 고르는 모든 조합에 대해 계산
+func (c *calculator) enumerate(remaining []card.Card, need int, startIdx int) {
+	if need == 0 {
+		c.showdown()
+		return
+	}
+
+	pos := 5 - need
+	for i := startIdx; i <= len(remaining)-need; i++ {
+		c.board[pos] = remaining[i]
+		c.enumerate(remaining, need-1, i+1)
+	}
+}
+
+// 남은 카드를 매번 need장만큼 부분적으로 섞어서 앞의 카드들을 보드로 사용
+func (c *calculator) simulate(remaining []card.Card, need int, iterations int, r *rand.Rand) {
+	deck := make([]card.Card, len(remaining))
+	copy(deck, remaining)
+
+	for n := 0; n < iterations; n++ {
+		for i := 0; i < need; i++ {
+			j := i + r.Intn(len(deck)-i)
+			deck[i], deck[j] = deck[j], deck[i]
+			c.board[c.boardSize+i] = deck[i]
+		}
+		c.showdown()
+	}
+}
+
+func (c *calculator) showdown() {
+	copy(c.hand[2:], c.board)
+
+	var best card.HandValue
+	winnerCnt := 0
+	for i, hand := range c.hands {
+		c.hand[0], c.hand[1] = hand[0], hand[1]
+		c.values[i] = card.Evaluate(c.hand)
+
+		if c.values[i] > best {
+			best = c.values[i]
+			winnerCnt = 1
+		} else if c.values[i] == best {
+			winnerCnt++
+		}
+	}
+
+	for i, v := range c.values {
+		if v != best {
+			continue
+		}
+		if winnerCnt == 1 {
+			c.wins[i]++
+		} else {
+			c.ties[i]++
+		}
+		c.equity[i] += 1 / float64(winnerCnt)
+	}
+	c.samples++
+}
+
+func (c *calculator) result(mode Mode) *Result {
+	res := &Result{
+		Players: make([]PlayerEquity, len(c.hands)),
+		Mode:    mode,
+		Samples: c.samples,
+	}
+
+	for i := range c.hands {
+		res.Players[i] = PlayerEquity{
+			Win:    c.wins[i] / float64(c.samples) * 100,
+			Tie:    c.ties[i] / float64(c.samples) * 100,
+			Equity: c.equity[i] / float64(c.samples) * 100,
+		}
+	}
+	return res
+}
+
+func fullDeck() []card.Card {
+	var deck []card.Card
+	for _, s := range []card.Symbol{card.Spade, card.Heart, card.Diamond, card.Clover} {
+		for r := card.Two; r <= card.Ace; r++ {
+			deck = append(deck, card.Card{Symbol: s, Rank: r})
+		}
+	}
+	return deck
+}
+
+// nCk (최대 52C5라서 int 범위를 넘지 않음)
+func combinations(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+
+	res := 1
+	for i := 1; i <= k; i++ {
+		res = res * (n - k + i) / i
+	}
+	return res
+}
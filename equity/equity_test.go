@@ -0,0 +1,154 @@
+package equity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/carderror"
+	"github.com/PudgeKim/go-holdem/errors/equityerror"
+)
+
+var aces = []card.Card{
+	{Symbol: card.Spade, Rank: card.Ace},
+	{Symbol: card.Heart, Rank: card.Ace},
+}
+
+var kings = []card.Card{
+	{Symbol: card.Spade, Rank: card.King},
+	{Symbol: card.Heart, Rank: card.King},
+}
+
+var flop = []card.Card{
+	{Symbol: card.Clover, Rank: card.Two},
+	{Symbol: card.Diamond, Rank: card.Seven},
+	{Symbol: card.Clover, Rank: card.Nine},
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestCalculateExhaustive(t *testing.T) {
+	res, err := Calculate([][]card.Card{aces, kings}, Options{Board: flop})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if res.Mode != Exhaustive {
+		t.Error("flop should be calculated exhaustively")
+	}
+	if res.Samples != 990 {
+		t.Errorf("45C2 should be 990 but got %d", res.Samples)
+	}
+
+	// KK는 남은 K 2장 중 하나가 나와야만 이김: 1 - 43C2/45C2 = 87/990
+	// 그 중 나머지 한 장이 A인 4가지는 AA가 더 높은 트리플이 되므로 83/990
+	kingsWin := 83.0 / 990 * 100
+	if !almostEqual(res.Players[1].Win, kingsWin) || !almostEqual(res.Players[1].Equity, kingsWin) {
+		t.Errorf("KK should win %f%% but got %f%%", kingsWin, res.Players[1].Win)
+	}
+	if !almostEqual(res.Players[0].Win, 100-kingsWin) {
+		t.Errorf("AA should win %f%% but got %f%%", 100-kingsWin, res.Players[0].Win)
+	}
+
+	// 죽은 카드에 K가 있으면 아웃이 1장으로 줄어듬: 1 - 43C2/44C2 = 43/946
+	// 여기서도 남은 A 2장과 같이 나오는 경우를 빼면 41/946
+	dead := []card.Card{{Symbol: card.Diamond, Rank: card.King}}
+	res, err = Calculate([][]card.Card{aces, kings}, Options{Board: flop, Dead: dead})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !almostEqual(res.Players[1].Win, 41.0/946*100) {
+		t.Errorf("KK should win %f%% but got %f%%", 41.0/946*100, res.Players[1].Win)
+	}
+}
+
+func TestCalculateTie(t *testing.T) {
+	board := []card.Card{
+		{Symbol: card.Spade, Rank: card.Ten},
+		{Symbol: card.Spade, Rank: card.Jack},
+		{Symbol: card.Spade, Rank: card.Queen},
+		{Symbol: card.Spade, Rank: card.King},
+		{Symbol: card.Spade, Rank: card.Ace},
+	}
+	hands := [][]card.Card{
+		{{Symbol: card.Heart, Rank: card.Two}, {Symbol: card.Heart, Rank: card.Three}},
+		{{Symbol: card.Diamond, Rank: card.Two}, {Symbol: card.Diamond, Rank: card.Three}},
+		{{Symbol: card.Clover, Rank: card.Two}, {Symbol: card.Clover, Rank: card.Three}},
+	}
+
+	res, err := Calculate(hands, Options{Board: board})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, p := range res.Players {
+		if p.Win != 0 || p.Tie != 100 || !almostEqual(p.Equity, 100.0/3) {
+			t.Errorf("every player should split the pot: %+v", p)
+		}
+	}
+}
+
+func TestCalculateMonteCarlo(t *testing.T) {
+	opts := Options{Iterations: 20000, Seed: 7}
+
+	res, err := Calculate([][]card.Card{aces, kings}, opts)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if res.Mode != MonteCarlo || res.Samples != 20000 {
+		t.Error("preflop should be calculated with Monte Carlo")
+	}
+
+	// AA vs KK (무늬 겹침) 프리플랍 에퀴티는 약 82%
+	if math.Abs(res.Players[0].Equity-82) > 1.5 {
+		t.Errorf("AA's equity should be about 82%% but got %f%%", res.Players[0].Equity)
+	}
+	if !almostEqual(res.Players[0].Equity+res.Players[1].Equity, 100) {
+		t.Error("sum of equities should be 100%")
+	}
+
+	// 같은 시드면 같은 결과
+	again, _ := Calculate([][]card.Card{aces, kings}, opts)
+	if again.Players[0] != res.Players[0] {
+		t.Error("same seed should produce same result")
+	}
+}
+
+func TestCalculateInvalidInput(t *testing.T) {
+	if _, err := Calculate([][]card.Card{aces}, Options{}); err != equityerror.NotEnoughPlayers {
+		t.Error("one player should return NotEnoughPlayers")
+	}
+
+	if _, err := Calculate([][]card.Card{aces, kings[:1]}, Options{}); err != equityerror.InvalidHoleCards {
+		t.Error("one hole card should return InvalidHoleCards")
+	}
+
+	if _, err := Calculate([][]card.Card{aces, aces}, Options{}); err != carderror.DuplicateCard {
+		t.Error("same hole cards should return DuplicateCard")
+	}
+
+	if _, err := Calculate([][]card.Card{aces, kings}, Options{Board: append(flop, aces[0])}); err != carderror.DuplicateCard {
+		t.Error("board card in hands should return DuplicateCard")
+	}
+
+	board := append(append([]card.Card{}, flop...), card.Card{Symbol: card.Heart, Rank: card.Two},
+		card.Card{Symbol: card.Heart, Rank: card.Three}, card.Card{Symbol: card.Heart, Rank: card.Four})
+	if _, err := Calculate([][]card.Card{aces, kings}, Options{Board: board}); err != equityerror.InvalidBoard {
+		t.Error("six board cards should return InvalidBoard")
+	}
+
+	if _, err := Calculate([][]card.Card{aces, kings}, Options{Iterations: -1}); err != equityerror.InvalidIterations {
+		t.Error("negative iterations should return InvalidIterations")
+	}
+
+	// 홀카드와 죽은 카드를 빼면 보드를 채울 카드가 2장밖에 남지 않음
+	var dead []card.Card
+	for _, c := range fullDeck()[2:] {
+		if c != aces[0] && c != aces[1] && c != kings[0] && c != kings[1] {
+			dead = append(dead, c)
+		}
+	}
+	if _, err := Calculate([][]card.Card{aces, kings}, Options{Dead: dead}); err != equityerror.NotEnoughCards {
+		t.Error("too few unseen cards should return NotEnoughCards")
+	}
+}
@@ -0,0 +1,16 @@
+package carderror
+
+import "errors"
+
+var (
+	InvalidCardCount   = errors.New("hand must have between 5 and 7 cards")
+	InvalidCard        = errors.New("card has an invalid symbol or rank")
+	DuplicateCard      = errors.New("same card is used more than once")
+	InvalidNotation    = errors.New("card notation must be a rank (2-9, T, J, Q, K, A) followed by a suit (s, h, d, c)")
+	CommitmentMismatch = errors.New("server seed doesn't match the commitment")
+	DeckMismatch       = errors.New("deck doesn't match the one derived from the seeds")
+	EmptyDeck          = errors.New("no cards left in the deck")
+	NotEnoughCards     = errors.New("not enough cards left in the deck")
+	InvalidHoleCards   = errors.New("hole cards must be exactly two cards")
+	InvalidDrawBoard   = errors.New("board must have three or four cards to analyze draws")
+)
@@ -0,0 +1,11 @@
+package equityerror
+
+import "errors"
+
+var (
+	NotEnoughPlayers  = errors.New("equity needs at least two players")
+	InvalidHoleCards  = errors.New("each player must have exactly two hole cards")
+	InvalidBoard      = errors.New("board can't have more than five cards")
+	NotEnoughCards    = errors.New("not enough unseen cards left to complete the board")
+	InvalidIterations = errors.New("iterations can't be negative")
+)
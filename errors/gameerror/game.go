@@ -13,4 +13,25 @@ var (
 	AlreadyStarted        = errors.New("game is already started")
 	GiveCardsError 		  = errors.New("players couldn't hand out the cards")
 	NotEnoughBalance      = errors.New("game balance must be equal or lower than user's balance")
+	InvalidClientSeed     = errors.New("client seed must be 1 to 64 characters without commas")
+	InvalidBoardStreet    = errors.New("board cards can only be dealt in order of flop, turn and river")
+	GameNotStarted        = errors.New("game is not started")
+	InvalidActionType     = errors.New("action must be one of Fold, Check, Call, Bet, Raise and AllIn")
+	CheckNotAllowed       = errors.New("player can't check when there is a bet to call")
+	CallNotAllowed        = errors.New("player can't call when there is no bet to call")
+	BetNotAllowed         = errors.New("player can't bet when there is already a bet (raise instead)")
+	RaiseNotAllowed       = errors.New("player can't raise when there is no bet (bet instead)")
+	LowRaise              = errors.New("raise must be at least the size of the last raise unless player is all-in")
+	NoActionClock         = errors.New("there is no action clock running")
+	ActionNotExpired      = errors.New("player's action time is not expired yet")
+	TimeBankEmpty         = errors.New("player has no time bank left")
+	RunoutInProgress      = errors.New("all-in runout of the last hand is still in progress")
+	InvalidPhaseTransition = errors.New("hand can't move to the requested phase from the current phase")
+	InvalidEventLog       = errors.New("event log must start with GameCreated and the host's PlayerSeated")
+	EventLogMismatch      = errors.New("event doesn't match the game it is applied to")
+	StaleGame             = errors.New("game was changed by another request (event log already has newer events)")
+	NotShowdown           = errors.New("hands can only be shown or mucked during the showdown")
+	InvalidShowdownDecision = errors.New("showdown decision must be Show or Muck")
+	EmptyGameBalance      = errors.New("player without game balance can't be ready")
+	SeatDuringHand        = errors.New("players can only sit down between hands")
 )
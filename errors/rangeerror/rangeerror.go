@@ -0,0 +1,9 @@
+package rangeerror
+
+import "errors"
+
+var (
+	InvalidNotation = errors.New("range must be hands like AA, AKs, AKo, AK, AsKh with optional + or - (e.g. TT+, 76s-54s)")
+	InvalidSpan     = errors.New("both ends of a range must have the same shape (e.g. 76s-54s, A5o-A2o, 99-66)")
+	InvalidWeight   = errors.New("weight must be a number between 0 and 1")
+)
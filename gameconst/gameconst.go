@@ -1,13 +1,5 @@
 package gameconst
 
-const (
-	FreeFlop = "FreeFlop"
-	Flop     = "Flop"
-	Turn     = "Turn"
-	River    = "River"
-	GameEnd  = "GameEnd"
-)
-
 type BetType int
 
 const (
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/domain/entity"
 	"github.com/PudgeKim/go-holdem/service"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -34,6 +37,12 @@ type CreateGameReq struct {
 	UserId int64 `json:"user_id" binding:"required"`
 	GameBalance uint64 `json:"game_balance" binding:"required"`
 	MinBetAmount uint64 `json:"min_bet_amount" binding:"required"`
+	Ante uint64 `json:"ante"` // 0이면 앤티 없음
+	ShortDeck bool `json:"short_deck"` // true면 2~5를 뺀 36장 덱으로 진행
+	DeadButton bool `json:"dead_button"` // true면 플레이어가 나갔을 때 dead button 규칙으로 블라인드를 옮김
+	ActionTimeoutSeconds *uint `json:"action_timeout_seconds"` // 한 액션마다 주어지는 시간 (없으면 기본값, 0이면 시간 제한 없음)
+	TimeBankSeconds *uint `json:"time_bank_seconds"` // 플레이어마다 주어지는 타임뱅크 (없으면 기본값)
+	RunoutDelayMillis *uint `json:"runout_delay_millis"` // 올인 런아웃에서 스트릿 사이의 간격 (없으면 기본값, 0이면 바로 결과를 보냄)
 }
 
 func (g *GameHandler) CreateGameRoom(c *gin.Context) {
@@ -62,7 +71,24 @@ func (g *GameHandler) CreateGameRoom(c *gin.Context) {
 		return 
 	}
 
-	game, err := g.gameService.CreateGame(c, user, createGameReq.GameBalance, createGameReq.MinBetAmount); if err != nil {
+	opts := []entity.GameOption{entity.WithAnte(createGameReq.Ante)}
+	if createGameReq.ShortDeck {
+		opts = append(opts, entity.WithVariant(card.ShortDeck))
+	}
+	if createGameReq.DeadButton {
+		opts = append(opts, entity.WithButtonPolicy(entity.DeadButton))
+	}
+	if createGameReq.ActionTimeoutSeconds != nil {
+		opts = append(opts, entity.WithActionTimeout(time.Duration(*createGameReq.ActionTimeoutSeconds) * time.Second))
+	}
+	if createGameReq.TimeBankSeconds != nil {
+		opts = append(opts, entity.WithTimeBank(time.Duration(*createGameReq.TimeBankSeconds) * time.Second))
+	}
+	if createGameReq.RunoutDelayMillis != nil {
+		opts = append(opts, entity.WithRunoutDelay(time.Duration(*createGameReq.RunoutDelayMillis) * time.Millisecond))
+	}
+
+	game, err := g.gameService.CreateGame(c, user, createGameReq.GameBalance, createGameReq.MinBetAmount, opts...); if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
@@ -71,6 +97,7 @@ func (g *GameHandler) CreateGameRoom(c *gin.Context) {
 	c.JSON(http.StatusCreated, gin.H{
 		"room_id": game.RoomId.String(),
 		"hostname": game.HostName,
+		"variant": game.Variant.String(),
 	})
 }
 
@@ -81,9 +108,14 @@ type GameReq struct {
 	Type string `json:"type" binding:"required"`
 	Nickname string `json:"nickname" binding:"required"`
 	Message string `json:"message"`
+	Action string `json:"action"` // Fold, Check, Call, Bet, Raise, AllIn
 	BetAmount  uint64 `json:"bet_amount"`
 	IsDead     bool `json:"is_dead"`
 	IsReady bool `json:"is_ready"`
+	ClientSeed string `json:"client_seed"`
+	TimeBankSeconds uint `json:"time_bank_seconds"` // 타임뱅크에서 꺼내 쓸 시간 (0이면 남은 타임뱅크를 모두 사용)
+	AutoMuck bool `json:"auto_muck"` // 쇼다운에서 이길 수 없는 핸드를 자동으로 버릴지
+	Decision string `json:"decision"` // 쇼다운에서 버릴 수 있는 핸드를 공개할지 (Show, Muck)
 }
 
 // room에 들어가는 순간 websocket을 통해
@@ -123,6 +155,11 @@ func (g *GameHandler) JoinRoom(c *gin.Context) {
 		return 
 	}
 	
+	// 서버가 재시작된 경우 저장된 마감 시간으로 타이머를 다시 시작함
+	if _, err := g.gameService.ResumeShotClock(c, roomId); err != nil {
+		fmt.Println("ResumeShotClockErr: ", err.Error())
+	}
+
 	// 다른유저들로부터 채팅이 오면 받아서 전달 
 	go func ()  {
 		for {
@@ -159,10 +196,96 @@ func (g *GameHandler) JoinRoom(c *gin.Context) {
 			if err := ws.WriteJSON(res); err != nil {
 				fmt.Println("GameStartWriteJsonErr2: ", err.Error())
 			}
+		case "bet":
+			betInfo := service.BetInfo{
+				PlayerName: gameReq.Nickname,
+				Action: entity.ActionType(gameReq.Action),
+				BetAmount: gameReq.BetAmount,
+				IsDead: gameReq.IsDead,
+			}
+			res, err := g.gameService.Bet(c, gameReq.RoomId, betInfo)
+			if err != nil {
+				if err := ws.WriteJSON(ErrorResponse{Error: err.Error()}); err != nil {
+					fmt.Println("BetWriteJsonErr: ", err.Error())
+				}
+				continue
+			}
+			if err := ws.WriteJSON(res); err != nil {
+				fmt.Println("BetWriteJsonErr: ", err.Error())
+			}
+		case "timebank":
+			res, err := g.gameService.ExtendTimeBank(c, gameReq.RoomId, gameReq.Nickname, time.Duration(gameReq.TimeBankSeconds) * time.Second)
+			if err != nil {
+				if err := ws.WriteJSON(ErrorResponse{Error: err.Error()}); err != nil {
+					fmt.Println("TimeBankWriteJsonErr: ", err.Error())
+				}
+				continue
+			}
+			if err := ws.WriteJSON(res); err != nil {
+				fmt.Println("TimeBankWriteJsonErr: ", err.Error())
+			}
 		case "ready":
 			if err := g.gameService.HandleReady(c, gameReq.RoomId, gameReq.Nickname, gameReq.IsReady); err != nil {
 				fmt.Println("Ready: ", err.Error())
 			}
+		case "showdown":
+			// 공개된 핸드는 서비스에서 방의 모든 플레이어에게 보내고 여기서는 결정한 플레이어에게 결과를 돌려줌
+			res, err := g.gameService.Decide(c, gameReq.RoomId, gameReq.Nickname, entity.ShowdownDecision(gameReq.Decision))
+			if err != nil {
+				if err := ws.WriteJSON(ErrorResponse{Error: err.Error()}); err != nil {
+					fmt.Println("ShowdownWriteJsonErr: ", err.Error())
+				}
+				continue
+			}
+			if err := ws.WriteJSON(res); err != nil {
+				fmt.Println("ShowdownWriteJsonErr: ", err.Error())
+			}
+		case "leave":
+			// 나간 플레이어는 더 이상 방의 메시지를 받지 않으므로 연결을 끊음
+			res, err := g.gameService.LeaveGame(c, gameReq.RoomId, gameReq.Nickname)
+			if err != nil {
+				if err := ws.WriteJSON(ErrorResponse{Error: err.Error()}); err != nil {
+					fmt.Println("LeaveWriteJsonErr: ", err.Error())
+				}
+				continue
+			}
+			if res != nil {
+				if err := ws.WriteJSON(res); err != nil {
+					fmt.Println("LeaveWriteJsonErr: ", err.Error())
+				}
+			}
+			if err := g.chatService.UnSubscribe(c, gameReq.RoomId, userId); err != nil {
+				fmt.Println("LeaveUnSubscribeErr: ", err.Error())
+			}
+			return
+		case "automuck":
+			if err := g.gameService.SetAutoMuck(c, gameReq.RoomId, gameReq.Nickname, gameReq.AutoMuck); err != nil {
+				if err := ws.WriteJSON(ErrorResponse{Error: err.Error()}); err != nil {
+					fmt.Println("AutoMuckWriteJsonErr: ", err.Error())
+				}
+			}
+		case "commitment":
+			res, err := g.gameService.GetSeedCommitment(c, gameReq.RoomId)
+			if err != nil {
+				if err := ws.WriteJSON(ErrorResponse{Error: err.Error()}); err != nil {
+					fmt.Println("CommitmentWriteJsonErr: ", err.Error())
+				}
+				continue
+			}
+			if err := ws.WriteJSON(res); err != nil {
+				fmt.Println("CommitmentWriteJsonErr: ", err.Error())
+			}
+		case "seed":
+			res, err := g.gameService.AddClientSeed(c, gameReq.RoomId, gameReq.Nickname, gameReq.ClientSeed)
+			if err != nil {
+				if err := ws.WriteJSON(ErrorResponse{Error: err.Error()}); err != nil {
+					fmt.Println("SeedWriteJsonErr: ", err.Error())
+				}
+				continue
+			}
+			if err := ws.WriteJSON(res); err != nil {
+				fmt.Println("SeedWriteJsonErr: ", err.Error())
+			}
 		}
 		
 
@@ -0,0 +1,299 @@
+// handrange는 "AKs, TT+, 76s-54s, A5o+" 같은 레인지 표기를 실제 홀카드 조합들로 바꿈
+//
+// 지원하는 표기
+//
+//	AA, AKs, AKo     : 포켓페어, 수딧, 오프수딧
+//	AK               : 수딧과 오프수딧 모두
+//	AsKh             : 특정 조합 한개
+//	TT+, A5o+        : 페어는 AA까지, 나머지는 높은 카드는 그대로 두고 낮은 카드를 높은 카드 바로 아래까지
+//	99-66, A5s-A2s   : 양 끝을 포함한 구간
+//	76s-54s          : 두 카드의 간격을 유지하면서 내려가는 구간
+//	AKs:0.5          : 뒤에 :가중치(0~1)를 붙이면 해당 조합들의 가중치가 됨 (기본 1)
+//
+// 각 표기는 쉼표나 공백으로 구분하고 같은 조합이 여러번 나오면 나중에 나온 가중치를 사용함
+package handrange
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/rangeerror"
+)
+
+var suits = []card.Symbol{card.Spade, card.Heart, card.Diamond, card.Clover}
+
+// Combo는 두 장의 홀카드 조합
+// Cards[0]이 항상 랭크가 높은 카드 (같은 랭크면 s, h, d, c 순으로 앞선 카드)
+type Combo struct {
+	Cards  [2]card.Card
+	Weight float64
+}
+
+// Hand는 equity.Calculate 등에 바로 넘길 수 있는 형태로 리턴함
+func (c Combo) Hand() []card.Card {
+	return []card.Card{c.Cards[0], c.Cards[1]}
+}
+
+func (c Combo) String() string {
+	return c.Cards[0].String() + c.Cards[1].String()
+}
+
+// Range는 표기에 나온 순서대로 정렬된 조합들
+type Range []Combo
+
+// Parse는 레인지 표기를 조합들로 바꿈
+func Parse(s string) (Range, error) {
+	tokens := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	var r Range
+	indexes := make(map[[2]card.Card]int)
+
+	for _, token := range tokens {
+		notation, weight, err := splitWeight(token)
+		if err != nil {
+			return nil, err
+		}
+
+		combos, err := expand(notation)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cards := range combos {
+			if idx, ok := indexes[cards]; ok {
+				r[idx].Weight = weight
+				continue
+			}
+			indexes[cards] = len(r)
+			r = append(r, Combo{Cards: cards, Weight: weight})
+		}
+	}
+	return r, nil
+}
+
+// RemoveBlocked는 dead에 포함된 카드를 사용하는 조합들을 뺀 레인지를 리턴함
+// (내 홀카드나 보드처럼 상대가 가질 수 없는 카드들)
+func (r Range) RemoveBlocked(dead []card.Card) Range {
+	blocked := make(map[card.Card]bool, len(dead))
+	for _, c := range dead {
+		blocked[c] = true
+	}
+
+	var filtered Range
+	for _, combo := range r {
+		if blocked[combo.Cards[0]] || blocked[combo.Cards[1]] {
+			continue
+		}
+		filtered = append(filtered, combo)
+	}
+	return filtered
+}
+
+// TotalWeight는 가중치를 반영한 조합 수를 리턴함
+func (r Range) TotalWeight() float64 {
+	var total float64
+	for _, combo := range r {
+		total += combo.Weight
+	}
+	return total
+}
+
+func splitWeight(token string) (string, float64, error) {
+	idx := strings.Index(token, ":")
+	if idx < 0 {
+		return token, 1, nil
+	}
+
+	weight, err := strconv.ParseFloat(token[idx+1:], 64)
+	if err != nil || weight < 0 || weight > 1 {
+		return "", 0, rangeerror.InvalidWeight
+	}
+	return token[:idx], weight, nil
+}
+
+// handClass는 무늬를 정하지 않은 핸드 (예: AKs, 76o, TT)
+type handClass struct {
+	high    card.Rank
+	low     card.Rank
+	suited  bool
+	offsuit bool
+}
+
+func (h handClass) isPair() bool {
+	return h.high == h.low
+}
+
+func parseClass(s string) (handClass, error) {
+	runes := []rune(s)
+	if len(runes) != 2 && len(runes) != 3 {
+		return handClass{}, rangeerror.InvalidNotation
+	}
+
+	high, err := card.ParseRank(string(runes[0]))
+	if err != nil {
+		return handClass{}, rangeerror.InvalidNotation
+	}
+	low, err := card.ParseRank(string(runes[1]))
+	if err != nil {
+		return handClass{}, rangeerror.InvalidNotation
+	}
+	if high < low {
+		high, low = low, high
+	}
+
+	h := handClass{high: high, low: low}
+	if len(runes) == 3 {
+		switch unicode.ToLower(runes[2]) {
+		case 's':
+			h.suited = true
+		case 'o':
+			h.offsuit = true
+		default:
+			return handClass{}, rangeerror.InvalidNotation
+		}
+
+		// 페어는 수딧이 될 수 없고 오프수딧 표기도 사용하지 않음
+		if h.isPair() {
+			return handClass{}, rangeerror.InvalidNotation
+		}
+	}
+	return h, nil
+}
+
+func expand(notation string) ([][2]card.Card, error) {
+	if parts := strings.Split(notation, "-"); len(parts) == 2 {
+		return expandSpan(parts[0], parts[1])
+	}
+
+	if strings.HasSuffix(notation, "+") {
+		h, err := parseClass(strings.TrimSuffix(notation, "+"))
+		if err != nil {
+			return nil, err
+		}
+
+		var combos [][2]card.Card
+		if h.isPair() {
+			for r := h.high; r <= card.Ace; r++ {
+				combos = append(combos, handClass{high: r, low: r}.combos()...)
+			}
+			return combos, nil
+		}
+
+		for r := h.low; r < h.high; r++ {
+			combos = append(combos, handClass{high: h.high, low: r, suited: h.suited, offsuit: h.offsuit}.combos()...)
+		}
+		return combos, nil
+	}
+
+	if len([]rune(notation)) == 4 {
+		cards, err := card.ParseCards(notation)
+		if err != nil || cards[0] == cards[1] {
+			return nil, rangeerror.InvalidNotation
+		}
+		return [][2]card.Card{newCombo(cards[0], cards[1])}, nil
+	}
+
+	h, err := parseClass(notation)
+	if err != nil {
+		return nil, err
+	}
+	return h.combos(), nil
+}
+
+func expandSpan(from, to string) ([][2]card.Card, error) {
+	h1, err := parseClass(from)
+	if err != nil {
+		return nil, err
+	}
+	h2, err := parseClass(to)
+	if err != nil {
+		return nil, err
+	}
+
+	if h1.suited != h2.suited || h1.offsuit != h2.offsuit || h1.isPair() != h2.isPair() {
+		return nil, rangeerror.InvalidSpan
+	}
+
+	var classes []handClass
+	switch {
+	case h1.isPair():
+		lo, hi := minRank(h1.high, h2.high), maxRank(h1.high, h2.high)
+		for r := hi; r >= lo; r-- {
+			classes = append(classes, handClass{high: r, low: r})
+		}
+
+	case h1.high == h2.high: // A5s-A2s
+		lo, hi := minRank(h1.low, h2.low), maxRank(h1.low, h2.low)
+		for r := hi; r >= lo; r-- {
+			classes = append(classes, handClass{high: h1.high, low: r, suited: h1.suited, offsuit: h1.offsuit})
+		}
+
+	case h1.high-h1.low == h2.high-h2.low: // 76s-54s
+		gap := h1.high - h1.low
+		lo, hi := minRank(h1.high, h2.high), maxRank(h1.high, h2.high)
+		for r := hi; r >= lo; r-- {
+			classes = append(classes, handClass{high: r, low: r - gap, suited: h1.suited, offsuit: h1.offsuit})
+		}
+
+	default:
+		return nil, rangeerror.InvalidSpan
+	}
+
+	var combos [][2]card.Card
+	for _, h := range classes {
+		combos = append(combos, h.combos()...)
+	}
+	return combos, nil
+}
+
+// 페어는 6개, 수딧은 4개, 오프수딧은 12개, 둘 다 포함하면 16개의 조합이 나옴
+func (h handClass) combos() [][2]card.Card {
+	var combos [][2]card.Card
+
+	for i, s1 := range suits {
+		for j, s2 := range suits {
+			if h.isPair() && j <= i {
+				continue
+			}
+			if !h.isPair() && (h.suited && s1 != s2 || h.offsuit && s1 == s2) {
+				continue
+			}
+			combos = append(combos, newCombo(card.Card{Symbol: s1, Rank: h.high}, card.Card{Symbol: s2, Rank: h.low}))
+		}
+	}
+	return combos
+}
+
+func newCombo(c1, c2 card.Card) [2]card.Card {
+	if c1.Rank < c2.Rank || c1.Rank == c2.Rank && suitOrder(c1.Symbol) > suitOrder(c2.Symbol) {
+		c1, c2 = c2, c1
+	}
+	return [2]card.Card{c1, c2}
+}
+
+func suitOrder(s card.Symbol) int {
+	for i, suit := range suits {
+		if suit == s {
+			return i
+		}
+	}
+	return len(suits)
+}
+
+func minRank(a, b card.Rank) card.Rank {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxRank(a, b card.Rank) card.Rank {
+	if a > b {
+		return a
+	}
+	return b
+}
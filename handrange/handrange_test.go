@@ -0,0 +1,124 @@
+package handrange
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/errors/rangeerror"
+)
+
+func comboStrings(r Range) string {
+	s := make([]string, len(r))
+	for i, c := range r {
+		s[i] = c.String()
+	}
+	return strings.Join(s, " ")
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		notation string
+		count    int
+	}{
+		{"AA", 6},
+		{"AKs", 4},
+		{"AKo", 12},
+		{"AK", 16},
+		{"KA", 16},
+		{"AsKh", 1},
+		{"TT+", 30},
+		{"A5o+", 108}, // A5o ~ AKo 9개
+		{"A5s-A2s", 16},
+		{"99-66", 24},
+		{"66-99", 24},
+		{"76s-54s", 12},
+		{"AKs, TT+, 76s-54s, A5o+", 4 + 30 + 12 + 108},
+		{"AK AKs", 16}, // 중복된 조합은 한번만 들어감
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		r, err := Parse(tt.notation)
+		if err != nil {
+			t.Errorf("%q: %s", tt.notation, err.Error())
+			continue
+		}
+		if len(r) != tt.count {
+			t.Errorf("%q: expected %d combos but got %d", tt.notation, tt.count, len(r))
+		}
+	}
+
+	r, _ := Parse("76s-54s")
+	if comboStrings(r) != "7s6s 7h6h 7d6d 7c6c 6s5s 6h5h 6d5d 6c5c 5s4s 5h4h 5d4d 5c4c" {
+		t.Errorf("unexpected combos: %s", comboStrings(r))
+	}
+
+	r, _ = Parse("QQ")
+	if comboStrings(r) != "QsQh QsQd QsQc QhQd QhQc QdQc" {
+		t.Errorf("unexpected combos: %s", comboStrings(r))
+	}
+}
+
+func TestParseSpecificCombo(t *testing.T) {
+	r, err := Parse("2cAd")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected := Range{{Cards: [2]card.Card{{Symbol: card.Diamond, Rank: card.Ace}, {Symbol: card.Clover, Rank: card.Two}}, Weight: 1}}
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("unexpected range: %v", r)
+	}
+	if card.FormatCards(r[0].Hand()) != "Ad 2c" {
+		t.Error("Hand should return the two cards")
+	}
+}
+
+func TestParseWeights(t *testing.T) {
+	r, err := Parse("AKs:0.5, QQ+, KK:0.25")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if r.TotalWeight() != 4*0.5+12+6*0.25 {
+		t.Errorf("unexpected total weight: %f", r.TotalWeight())
+	}
+	if r[0].Weight != 0.5 || r[4].Weight != 1 || r[10].Weight != 0.25 {
+		t.Error("weights should be applied to each combo and overwritten by later notation")
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		notation string
+		err      error
+	}{
+		{"AKx", rangeerror.InvalidNotation},
+		{"A", rangeerror.InvalidNotation},
+		{"AAs", rangeerror.InvalidNotation},
+		{"1K", rangeerror.InvalidNotation},
+		{"AsAs", rangeerror.InvalidNotation},
+		{"76s-54o", rangeerror.InvalidSpan},
+		{"76s-A2s", rangeerror.InvalidSpan},
+		{"99-A2s", rangeerror.InvalidSpan},
+		{"AKs:2", rangeerror.InvalidWeight},
+		{"AKs:abc", rangeerror.InvalidWeight},
+	}
+
+	for _, tt := range tests {
+		if _, err := Parse(tt.notation); err != tt.err {
+			t.Errorf("%q: expected %v but got %v", tt.notation, tt.err, err)
+		}
+	}
+}
+
+func TestRemoveBlocked(t *testing.T) {
+	r, _ := Parse("AA, AKs")
+	dead, _ := card.ParseCards("As 7c")
+
+	r = r.RemoveBlocked(dead)
+	if comboStrings(r) != "AhAd AhAc AdAc AhKh AdKd AcKc" {
+		t.Errorf("unexpected combos: %s", comboStrings(r))
+	}
+}
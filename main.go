@@ -51,7 +51,9 @@ func main() {
 
 	authService := service.NewAuthService(userRepo)
 	chatService := service.NewChatService(chatRepo)
-	gameService := service.NewGameService(userRepo, gameRepo)
+	eventPublisher := persistence.NewEventPublisher(redisClient)
+	gameEventRepo := persistence.NewGameEventRepository(redisClient)
+	gameService := service.NewGameService(userRepo, gameRepo, gameEventRepo, eventPublisher)
 
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(r *http.Request) bool { return true },
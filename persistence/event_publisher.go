@@ -0,0 +1,32 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/PudgeKim/go-holdem/domain/repository"
+	"github.com/go-redis/redis/v8"
+)
+
+type eventPublisher struct {
+	redisClient *redis.Client
+}
+
+// NewEventPublisher는 채팅과 같은 redis 채널로 이벤트를 JSON으로 보내는 EventPublisher를 만듬
+// 웹소켓에서 채팅 메시지를 전달하는 고루틴이 이벤트도 그대로 클라이언트에게 전달함
+func NewEventPublisher(redisClient *redis.Client) repository.EventPublisher {
+	return &eventPublisher{
+		redisClient: redisClient,
+	}
+}
+
+func (e *eventPublisher) Publish(ctx context.Context, subscribeChan string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := e.redisClient.Publish(ctx, subscribeChan, payload).Err(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/PudgeKim/go-holdem/domain/entity"
+	"github.com/PudgeKim/go-holdem/domain/repository"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/go-redis/redis/v8"
+)
+
+type gameEventRepository struct {
+	redisClient *redis.Client
+}
+
+// NewGameEventRepository는 방마다 하나의 redis stream에 이벤트를 JSON으로 추가하는 GameEventRepository를 만듬
+func NewGameEventRepository(redisClient *redis.Client) repository.GameEventRepository {
+	return &gameEventRepository{
+		redisClient: redisClient,
+	}
+}
+
+func getEventStreamKey(roomId string) string {
+	return "events-" + roomId
+}
+
+// appendEventsScript는 stream의 마지막 ID가 첫 이벤트의 Seq 바로 전일 때만 이벤트들을 추가함
+// 스크립트는 redis에서 한번에 실행되므로 다른 요청이 끼어들어 로그의 일부만 추가되는 일이 없음
+// KEYS[1]: stream, ARGV[1]: 첫 이벤트의 Seq, ARGV[2]: 만료 시간(초), ARGV[3...]: 이벤트 JSON
+var appendEventsScript = redis.NewScript(`
+local last = redis.call('XREVRANGE', KEYS[1], '+', '-', 'COUNT', 1)
+local lastSeq = 0
+if #last > 0 then
+	lastSeq = tonumber(string.match(last[1][1], '^(%d+)'))
+end
+local first = tonumber(ARGV[1])
+if lastSeq + 1 ~= first then
+	return 0
+end
+for i = 3, #ARGV do
+	redis.call('XADD', KEYS[1], string.format('%d-0', first + i - 3), 'event', ARGV[i])
+end
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return 1
+`)
+
+// AppendEvents는 이벤트의 Seq를 stream ID로 써서 추가하므로 같은 Seq가 두번 들어갈 수 없음
+func (g *gameEventRepository) AppendEvents(ctx context.Context, roomId string, events ...entity.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	args := []interface{}{events[0].Seq, int64(REDIS_TIME_DURATION.Seconds())}
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		args = append(args, payload)
+	}
+
+	appended, err := appendEventsScript.Run(ctx, g.redisClient, []string{getEventStreamKey(roomId)}, args...).Int()
+	if err != nil {
+		return err
+	}
+	if appended == 0 {
+		return gameerror.StaleGame
+	}
+	return nil
+}
+
+func (g *gameEventRepository) GetEvents(ctx context.Context, roomId string, fromSeq uint64) ([]entity.Event, error) {
+	messages, err := g.redisClient.XRange(ctx, getEventStreamKey(roomId), fmt.Sprintf("%d-0", fromSeq), "+").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var events []entity.Event
+	for _, message := range messages {
+		payload, ok := message.Values["event"].(string)
+		if !ok {
+			continue
+		}
+
+		var event entity.Event
+		if err := json.Unmarshal([]byte(payload), &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (g *gameEventRepository) DeleteEvents(ctx context.Context, roomId string) error {
+	if err := g.redisClient.Del(ctx, getEventStreamKey(roomId)).Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+type memoryGameEventRepository struct {
+	mu     sync.RWMutex
+	events map[string][]entity.Event
+}
+
+// NewMemoryGameEventRepository는 이벤트를 메모리에만 저장하는 GameEventRepository를 만듬 (테스트나 redis 없이 실행할 때 사용)
+func NewMemoryGameEventRepository() repository.GameEventRepository {
+	return &memoryGameEventRepository{
+		events: make(map[string][]entity.Event),
+	}
+}
+
+func (m *memoryGameEventRepository) AppendEvents(ctx context.Context, roomId string, events ...entity.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := m.events[roomId]
+	var lastSeq uint64
+	if len(stored) > 0 {
+		lastSeq = stored[len(stored)-1].Seq
+	}
+	if lastSeq+1 != events[0].Seq {
+		return gameerror.StaleGame
+	}
+
+	m.events[roomId] = append(stored, events...)
+	return nil
+}
+
+func (m *memoryGameEventRepository) GetEvents(ctx context.Context, roomId string, fromSeq uint64) ([]entity.Event, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var events []entity.Event
+	for _, event := range m.events[roomId] {
+		if event.Seq >= fromSeq {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (m *memoryGameEventRepository) DeleteEvents(ctx context.Context, roomId string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.events, roomId)
+	return nil
+}
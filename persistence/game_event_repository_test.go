@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PudgeKim/go-holdem/domain/entity"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+)
+
+func TestMemoryGameEventRepository(t *testing.T) {
+	ctx := context.Background()
+	eventRepo := NewMemoryGameEventRepository()
+
+	if err := eventRepo.AppendEvents(ctx, "room", entity.Event{Seq: 1, Type: entity.GameCreated}, entity.Event{Seq: 2, Type: entity.PlayerSeated}); err != nil {
+		t.Fatal(err)
+	}
+	if err := eventRepo.AppendEvents(ctx, "room", entity.Event{Seq: 3, Type: entity.PlayerReady}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 다른 요청이 먼저 같은 Seq의 이벤트를 추가했으면 하나도 추가하지 않음
+	if err := eventRepo.AppendEvents(ctx, "room", entity.Event{Seq: 3, Type: entity.PlayerReady}, entity.Event{Seq: 4, Type: entity.PlayerReady}); err != gameerror.StaleGame {
+		t.Errorf("appending a used seq should return %v but got %v", gameerror.StaleGame, err)
+	}
+
+	events, err := eventRepo.GetEvents(ctx, "room", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].Seq != 2 || events[1].Seq != 3 {
+		t.Errorf("events from seq 2 should be [2 3] but got %+v", events)
+	}
+
+	if err := eventRepo.DeleteEvents(ctx, "room"); err != nil {
+		t.Fatal(err)
+	}
+	if events, _ := eventRepo.GetEvents(ctx, "room", 1); len(events) != 0 {
+		t.Errorf("events should be deleted but got %+v", events)
+	}
+}
@@ -49,13 +49,13 @@ func (g *gameRepository) SaveGame(ctx context.Context, roomId string, game *enti
 	return nil 
 }
 
-func (g *gameRepository) CreateGame(ctx context.Context, hostPlayer *entity.Player, minBetAmount uint64) (*entity.Game, string, error) {
+func (g *gameRepository) CreateGame(ctx context.Context, hostPlayer *entity.Player, minBetAmount uint64, opts ...entity.GameOption) (*entity.Game, string, error) {
 	roomId, err := uuid.NewRandom()
 	if err != nil {
 		return nil, "", err
 	}
 
-	game := entity.NewGame(roomId, ROOM_LIMIT, hostPlayer, minBetAmount)
+	game := entity.NewGame(roomId, ROOM_LIMIT, hostPlayer, minBetAmount, opts...)
 	return game, roomId.String(), nil 
 }
 
@@ -79,32 +79,3 @@ func (g *gameRepository) FindPlayer(ctx context.Context, roomId string, nickname
 	}
 	return nil, gameerror.NoPlayerExists
 }
-
-func (g *gameRepository) AddPlayer(ctx context.Context, roomId string, player *entity.Player) error {
-	game, err := g.GetGame(ctx, roomId)
-	if err != nil {
-		return err
-	}
-
-	if game.IsPlayerExist(player.Nickname) {
-		return gameerror.PlayerAlreadyExists
-	}
-
-	if len(game.Players) > ROOM_LIMIT {
-		return gameerror.PlayerLimitationError
-	}
-
-	game.Players = append(game.Players, player)
-
-	if err := g.SaveGame(ctx, roomId, game); err != nil {
-		return err 
-	}
-
-	return nil
-}
-
-
-
-
-
-
@@ -1,7 +1,17 @@
 package service
 
+import "github.com/PudgeKim/go-holdem/domain/entity"
+
 type BetInfo struct {
 	PlayerName string
-	BetAmount  uint64
-	IsDead     bool
+	Action     entity.ActionType
+	BetAmount  uint64 // Bet, Raise일 때 이번에 새로 내는 금액
+	IsDead     bool   // 예전 클라이언트용 (true면 Fold로 처리)
+}
+
+func (b BetInfo) toAction() entity.Action {
+	if b.IsDead {
+		return entity.Action{Type: entity.Fold}
+	}
+	return entity.Action{Type: b.Action, Amount: b.BetAmount}
 }
@@ -2,25 +2,14 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/PudgeKim/go-holdem/card"
 	"github.com/PudgeKim/go-holdem/domain/entity"
 	"github.com/PudgeKim/go-holdem/domain/repository"
 	"github.com/PudgeKim/go-holdem/errors/gameerror"
 )
-type BetType string 
-const (
-	CHECK = "Check"
-	RAISE = "Raise"
-	ALLIN = "AllIn"
-)
-
-const (
-	FreeFlop = "FreeFlop"
-	Flop     = "Flop"
-	Turn     = "Turn"
-	River    = "River"
-	GameEnd  = "GameEnd"
-)
 
 // 게임이 처음 시작되는 경우
 // smallBlind  = 0번째 인덱스에 해당하는 플레이어 (준비를 한 경우)
@@ -30,26 +19,102 @@ const (
 type GameService struct {
 	userRepo repository.UserRepository
 	gameRepo repository.GameRepository
+	eventRepo repository.GameEventRepository
+	shotClock *ShotClock
+	runout    *Runout
+	roomLocks *RoomLocks
+	publisher repository.EventPublisher
 }
 
-func NewGameService(userRepo repository.UserRepository, gameRepo repository.GameRepository) *GameService {
-	return &GameService{
+// publisher가 nil이면 타이머, 런아웃, 쇼다운 이벤트를 클라이언트에게 보내지 않음 (타이머는 그대로 동작함)
+// eventRepo가 nil이면 이벤트 로그를 저장하지 않음 (저장된 게임이 없어지면 복구할 수 없음)
+func NewGameService(userRepo repository.UserRepository, gameRepo repository.GameRepository, eventRepo repository.GameEventRepository, publisher repository.EventPublisher) *GameService {
+	gameService := &GameService{
 		userRepo: userRepo,
 		gameRepo: gameRepo,
+		eventRepo: eventRepo,
+		roomLocks: NewRoomLocks(),
+		publisher: publisher,
 	}
+	gameService.shotClock = NewShotClock(gameService, publisher)
+	gameService.runout = NewRunout(publisher)
+	return gameService
 }
 
+// GetGame은 저장된 게임에 그 이후에 이벤트 로그에 추가된 이벤트들을 반영해서 리턴함
+// 저장된 게임을 가져올 수 없으면 이벤트 로그 전체로 복구함
 func (g *GameService) GetGame(ctx context.Context, roomId string) (*entity.Game, error) {
-	return g.gameRepo.GetGame(ctx, roomId)
+	game, err := g.gameRepo.GetGame(ctx, roomId)
+	if g.eventRepo == nil {
+		return game, err
+	}
+	if err != nil {
+		game, recoverErr := g.RecoverGame(ctx, roomId)
+		if recoverErr != nil {
+			return nil, err
+		}
+		return game, nil
+	}
+
+	// 이벤트 로그에 추가한 후 게임 저장에 실패했으면 저장된 게임이 로그보다 뒤처져 있음 (saveGame 참고)
+	events, err := g.eventRepo.GetEvents(ctx, roomId, game.Version+1)
+	if err != nil {
+		return nil, err
+	}
+	if err := game.ApplyEvents(events); err != nil {
+		return nil, err
+	}
+	return game, nil
+}
+
+// RecoverGame은 이벤트 로그를 처음부터 다시 적용해서 게임을 만들고 저장함
+func (g *GameService) RecoverGame(ctx context.Context, roomId string) (*entity.Game, error) {
+	if g.eventRepo == nil {
+		return nil, gameerror.InvalidEventLog
+	}
+
+	events, err := g.eventRepo.GetEvents(ctx, roomId, 1)
+	if err != nil {
+		return nil, err
+	}
+	game, err := entity.ReplayGame(events)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := g.saveGame(ctx, roomId, game); err != nil {
+		return nil, err
+	}
+	return game, nil
 }
 
+// saveGame은 새로 생긴 이벤트들을 이벤트 로그에 먼저 추가하고 성공했을 때만 게임을 저장함
+// 게임을 바꾸는 요청은 모두 이 함수로 저장해야 이벤트 로그가 항상 저장된 게임보다 앞서거나 같음
+//
+// 이벤트 로그가 기준이므로 로그에 추가하지 못하면 아무것도 저장되지 않은 것이고 에러를 리턴함 (호출한 곳에서 게임을 되돌려야함)
+// 그 사이에 다른 요청이 먼저 이벤트를 추가했으면 StaleGame을 리턴함
+// 로그에 추가한 후에 게임 저장이 실패하면 저장된 게임은 뒤처지지만 GetGame이 로그로 따라잡으므로 성공으로 봄
 func (g *GameService) saveGame(ctx context.Context, roomId string, game *entity.Game) error {
-	return g.gameRepo.SaveGame(ctx, roomId, game)
+	if g.eventRepo == nil {
+		game.TakeEvents()
+		return g.gameRepo.SaveGame(ctx, roomId, game)
+	}
+
+	if err := g.eventRepo.AppendEvents(ctx, roomId, game.PendingEvents()...); err != nil {
+		return err
+	}
+	game.TakeEvents()
+
+	if err := g.gameRepo.SaveGame(ctx, roomId, game); err != nil {
+		fmt.Println("SaveGameErr: ", err.Error())
+	}
+	return nil
 }
 
-func (g *GameService) CreateGame(ctx context.Context, hostUser *entity.User, hostGameBalance, minBetAmount uint64) (*entity.Game, error) {
+// opts로 앤티, 덱 종류, 버튼 규칙, 액션 시간 등을 지정함 (지정하지 않으면 기본값)
+func (g *GameService) CreateGame(ctx context.Context, hostUser *entity.User, hostGameBalance, minBetAmount uint64, opts ...entity.GameOption) (*entity.Game, error) {
 	hostPlayer := entity.NewPlayer(hostUser.Id, hostUser.Nickname, hostUser.Balance, hostGameBalance)
-	game, roomId, err := g.gameRepo.CreateGame(ctx, hostPlayer, minBetAmount); if err != nil {
+	game, roomId, err := g.gameRepo.CreateGame(ctx, hostPlayer, minBetAmount, opts...); if err != nil {
 		return nil, err 
 	}
 
@@ -61,23 +126,71 @@ func (g *GameService) CreateGame(ctx context.Context, hostUser *entity.User, hos
 }
 
 func (g *GameService) DeleteGame(ctx context.Context, roomId string) error {
-	return g.gameRepo.DeleteGame(ctx, roomId)
+	defer g.roomLocks.Lock(roomId)()
+	defer g.roomLocks.Remove(roomId)
+
+	if err := g.gameRepo.DeleteGame(ctx, roomId); err != nil {
+		return err
+	}
+	if g.eventRepo != nil {
+		return g.eventRepo.DeleteEvents(ctx, roomId)
+	}
+	return nil
 }
 
 func (g *GameService) AddUserToGame(ctx context.Context, roomId string, user *entity.User, gameBalance uint64) error {
+	defer g.roomLocks.Lock(roomId)()
+
 	if user.Balance < gameBalance {
 		return gameerror.NotEnoughBalance
 	}
 	
+	game, err := g.GetGame(ctx, roomId)
+	if err != nil {
+		return err
+	}
+
+	// 비어있는 자리에 앉힘 (이미 있는 플레이어거나 방이 꽉 찼으면 에러)
 	player := entity.NewPlayer(user.Id, user.Nickname, user.Balance, gameBalance)
-	if err := g.gameRepo.AddPlayer(ctx, roomId, player); err != nil {
+	if err := game.AddPlayer(player); err != nil {
 		return err 
 	}
-	return nil 
+	return g.saveGame(ctx, roomId, game)
+}
+
+// LeaveGame은 플레이어를 방에서 내보냄
+// 핸드 도중에 나가면 그 핸드는 폴드한 것으로 처리하고 자리는 핸드가 끝난 후에 비워짐
+// 나가서 베팅이나 핸드가 끝났을 수 있으므로 Bet처럼 처리 결과를 리턴함 (핸드 도중이 아니었으면 nil)
+func (g *GameService) LeaveGame(ctx context.Context, roomId string, nickname string) (*BetResponse, error) {
+	defer g.roomLocks.Lock(roomId)()
+
+	game, err := g.GetGame(ctx, roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	phase, board := game.Phase, append([]card.Card(nil), game.Board...)
+	result, err := game.Leave(nickname)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, g.saveGame(ctx, roomId, game)
+	}
+	return g.applyResult(ctx, game, nickname, result, phase, board)
 }
 
 func (g *GameService) FindPlayer(ctx context.Context, roomId string, nickname string) (*entity.Player, error) {
-	return g.gameRepo.FindPlayer(ctx, roomId, nickname)
+	game, err := g.GetGame(ctx, roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	p := game.FindPlayer(nickname)
+	if p == nil {
+		return nil, gameerror.NoPlayerExists
+	}
+	return p, nil
 }
 
 func (g *GameService) FindPlayerFromDB(ctx context.Context, nickname string) (*entity.User, error) {
@@ -88,6 +201,8 @@ func (g *GameService) FindPlayerFromDB(ctx context.Context, nickname string) (*e
 }
 
 func (g *GameService) StartGame(ctx context.Context, roomId string, hostName string) (*GameStartResponse, error) {
+	defer g.roomLocks.Lock(roomId)()
+
 	game, err := g.GetGame(ctx, roomId); if err != nil {
 		return nil, err 
 	}
@@ -96,39 +211,78 @@ func (g *GameService) StartGame(ctx context.Context, roomId string, hostName str
 		return nil, gameerror.AlreadyStarted
 	}
 
-	game.StartGame()
+	// 이전 핸드의 런아웃을 클라이언트들이 아직 보고 있음
+	if g.runout.IsRunning(roomId) {
+		return nil, gameerror.RunoutInProgress
+	}
 
-	if err := g.saveGame(ctx, roomId, game); err != nil {
-		return nil, err 
+	// 블라인드를 내거나 런아웃을 정산하다 실패하면 핸드 시작 전으로 되돌림
+	game.SetMemento()
+	if err := game.StartGame(); err != nil {
+		game.Undo()
+		return nil, err
 	}
-	
+
 	var readyPlayers []string 
+	var allInPlayers []string
 	for _, p := range game.GetReadyPlayers() {
 		readyPlayers = append(readyPlayers, p.Nickname)
+		if p.IsAllIn {
+			allInPlayers = append(allInPlayers, p.Nickname)
+		}
 	}
 
-	gameStartResponse := NewGameStartResponse(readyPlayers, game.GetFirstPlayer().Nickname, game.GetSmallBlind().Nickname, game.GetBigBlind().Nickname)
+	// SmallBlind가 dead면 이름과 금액을 비워서 보냄
+	var smallBlindName string
+	var smallBlindAmount uint64
+	if smallBlind := game.GetSmallBlind(); smallBlind != nil {
+		smallBlindName, smallBlindAmount = smallBlind.Nickname, smallBlind.CurrentBet
+	}
+	bigBlind := game.GetBigBlind()
+	gameStartResponse := NewGameStartResponse(readyPlayers, game.GetFirstPlayer().Nickname, smallBlindName, bigBlind.Nickname,
+		smallBlindAmount, bigBlind.CurrentBet, game.Ante, game.TotalBet, allInPlayers, game.SeedCommitment, game.ClientSeeds)
+	gameStartResponse.LegalActions = game.LegalActions(game.GetFirstPlayer())
+	gameStartResponse.ButtonSeat = game.ButtonSeat
+	if button := game.GetButton(); button != nil {
+		gameStartResponse.Button = button.Nickname
+	}
+
+	// 블라인드와 앤티만으로 올인되어 액션할 수 있는 플레이어가 한 명 이하면 바로 런아웃함 (정산 후 저장은 runOut에서 함)
+	if game.IsRunout() {
+		result, err := game.RunOut()
+		if err != nil {
+			game.Undo()
+			return nil, err
+		}
+		gameStartResponse.Runout = &BetResponse{}
+		if err := g.runOut(ctx, game, gameStartResponse.Runout, result, entity.PhasePreflop, nil); err != nil {
+			game.Undo()
+			return nil, err
+		}
+		return gameStartResponse, nil
+	}
+
+	if err := g.saveGame(ctx, roomId, game); err != nil {
+		game.Undo()
+		return nil, err 
+	}
+	g.shotClock.Start(roomId, game)
 	return gameStartResponse, nil 
 
 }
 
 func (g *GameService) HandleReady(ctx context.Context, roomId string, nickname string, isReady bool) error {
+	defer g.roomLocks.Lock(roomId)()
+
 	game, err := g.GetGame(ctx, roomId)
 	if err != nil {
 		return err
 	}
 
-	if game.IsStarted {
-		return gameerror.GameAlreadyStarted
-	}
-
-	p := game.FindPlayer(nickname)
-	if p == nil {
-		return gameerror.NoPlayerExists
+	if err := game.SetReady(nickname, isReady); err != nil {
+		return err
 	}
 
-	p.IsReady = isReady
-
 	if err := g.saveGame(ctx, roomId, game); err != nil {
 		return err 
 	}
@@ -136,222 +290,235 @@ func (g *GameService) HandleReady(ctx context.Context, roomId string, nickname s
 	return nil
 }
 
-func (g *GameService) Bet(ctx context.Context, roomId string, betInfo BetInfo) (*BetResponse, error) {
-	game, err := g.GetGame(ctx, roomId); if err != nil {
-		return nil, err 
+// SetAutoMuck은 쇼다운에서 이길 수 없는 핸드를 자동으로 버릴지 설정함
+func (g *GameService) SetAutoMuck(ctx context.Context, roomId string, nickname string, autoMuck bool) error {
+	defer g.roomLocks.Lock(roomId)()
+
+	game, err := g.GetGame(ctx, roomId)
+	if err != nil {
+		return err
 	}
-	// 게임 준비 고려해서 코드 짜야됨
 
-	var betResponse BetResponse
+	if err := game.SetAutoMuck(nickname, autoMuck); err != nil {
+		return err
+	}
+
+	return g.saveGame(ctx, roomId, game)
+}
 
-	nextPlayerName, isPlayerDead, playerCurBet, playerTotBet, gameCurBet, gameTotBet, isBetEnd, err := g.handleBet(ctx, roomId, game, betInfo)
+// 핸드 시작 전에 플레이어가 보낸 시드를 저장하고 commitment를 리턴
+func (g *GameService) AddClientSeed(ctx context.Context, roomId string, nickname string, seed string) (*SeedResponse, error) {
+	defer g.roomLocks.Lock(roomId)()
+
+	game, err := g.GetGame(ctx, roomId)
 	if err != nil {
-		return nil, err 
+		return nil, err
 	}
 
-	betResponse.IsBetEnd = isBetEnd
-	betResponse.IsPlayerDead = isPlayerDead
-	betResponse.PlayerCurrentBet = playerCurBet
-	betResponse.PlayerTotalBet = playerTotBet
-	betResponse.GameCurrentBet = gameCurBet
-	betResponse.GameTotalBet = gameTotBet
-	betResponse.NextPlayerName = nextPlayerName
+	if err := game.AddClientSeed(nickname, seed); err != nil {
+		return nil, err
+	}
 
-	switch game.Status {
-	case FreeFlop:
-		if isBetEnd {
-			game.Status = Flop
-		}
-		betResponse.GameStatus = Flop
-	case Flop:
-		if isBetEnd {
-			game.Status = Turn
-		}
-		betResponse.GameStatus = Turn
-	case Turn:
-		if isBetEnd {
-			game.Status = River
-		}
-		betResponse.GameStatus = River
-	case River:
-		if isBetEnd {
-			// 게임 종료
-			game.IsStarted = false 
-			game.Status = GameEnd
-			betResponse.GameStatus = GameEnd
-
-			// 승자 계산과 승자와 패자 잔고 업데이트
-			winners, losers := g.distributeMoneyToWinners(game)
-			winnersAndLosers := append(winners, losers...)
-			if err := g.updatePlayersBalance(ctx, winnersAndLosers...); err != nil {
-				return nil, err 
-			}
-			
-			var winnersName []string
-
-			for _, p := range winners {
-				winnersName = append(winnersName, p.Nickname)
-			}
-
-			betResponse.Winners = winnersName
-			
-			// 게임 초기화 
-			game.InitGame()
-
-			if err := g.saveGame(ctx, game.RoomId.String(), game); err != nil {
-				return nil, err 
-			}	
-		}
+	if err := g.saveGame(ctx, roomId, game); err != nil {
+		return nil, err
 	}
-	return &betResponse, nil 
+
+	return &SeedResponse{SeedCommitment: game.SeedCommitment}, nil
 }
 
-// handleBet 모든 플레이어들의 베팅이 종료되는 경우면 true를 리턴함
-// 다음 플레이어, 현재플레이어 isDead, 현재 플레이어의 currentBet, totalBet, 현재 게임의 currentBet, totalBet, 베팅종료, 에러 리턴
-func (g *GameService) handleBet(ctx context.Context, roomId string, game *entity.Game, betInfo BetInfo) (string, bool, uint64, uint64, uint64, uint64, bool, error) {
-	p := game.FindPlayer(betInfo.PlayerName)
-	if p == nil {
-		return "", false, 0, 0, 0, 0, false, gameerror.NoPlayerExists
+func (g *GameService) GetSeedCommitment(ctx context.Context, roomId string) (*SeedResponse, error) {
+	game, err := g.GetGame(ctx, roomId)
+	if err != nil {
+		return nil, err
 	}
+	return &SeedResponse{SeedCommitment: game.SeedCommitment}, nil
+}
 
-	expectedPlayer := game.Players[game.CurrentPlayerIdx]
-	if p.Nickname != expectedPlayer.Nickname {
-		return "", false, 0, 0, 0, 0, false, gameerror.InvalidPlayerTurn
-	}
-	if !p.IsReady {
-		return "", false, 0, 0, 0, 0, false, gameerror.PlayerNotReady
+func (g *GameService) Bet(ctx context.Context, roomId string, betInfo BetInfo) (*BetResponse, error) {
+	defer g.roomLocks.Lock(roomId)()
+
+	game, err := g.GetGame(ctx, roomId); if err != nil {
+		return nil, err 
 	}
-	if p.IsDead {
-		return "", false, 0, 0, 0, 0, false, gameerror.DeadPlayer
+
+	return g.applyAction(ctx, game, betInfo.PlayerName, betInfo.toAction())
+}
+
+// HandleTimeout은 deadline까지 액션하지 않은 플레이어 대신 체크하거나 폴드함 (쇼다운이면 핸드를 버림)
+// 그 사이에 플레이어가 액션했거나 타임뱅크를 써서 마감 시간이 바뀌었으면 ActionNotExpired를 리턴함
+// 방을 잠근 후에 게임을 가져오므로 같은 플레이어의 액션과 동시에 들어와도 둘 중 하나만 처리됨
+// (다른 서버가 먼저 게임을 바꿨으면 저장할 때 StaleGame을 리턴하고 자동 액션은 버려짐)
+func (g *GameService) HandleTimeout(ctx context.Context, roomId string, deadline time.Time) (*BetResponse, error) {
+	defer g.roomLocks.Lock(roomId)()
+
+	game, err := g.GetGame(ctx, roomId)
+	if err != nil {
+		return nil, err
 	}
-	if p.IsLeft {
-		return "", false, 0, 0, 0, 0, false, gameerror.PlayerLeft
+
+	if !game.ActionDeadline.Equal(deadline) {
+		return nil, gameerror.ActionNotExpired
 	}
 
-	// 플레이어가 베팅하는 대신 죽은 경우
-	if betInfo.IsDead {
-		p.IsDead = true
-		nextPlayerIdx, err := game.GetNextPlayerIdx()
+	if game.Phase == entity.PhaseShowdown {
+		nickname, decision, err := game.TimeoutDecision()
 		if err != nil {
-			return "", false, 0, 0, 0, 0, false, err
+			return nil, err
 		}
-		nextPlayer := game.Players[nextPlayerIdx].Nickname
-		return nextPlayer, true, p.CurrentBet, p.TotalBet, game.CurrentBet, game.TotalBet, false, nil
+		return g.applyDecision(ctx, game, nickname, decision)
 	}
 
-	betType := getBetType(p, betInfo.BetAmount, game)
-	if betType == ALLIN {
-		p.IsAllIn = true
+	nickname, action, err := game.TimeoutAction()
+	if err != nil {
+		return nil, err
 	}
+	return g.applyAction(ctx, game, nickname, action)
+}
 
-	p.CurrentBet += betInfo.BetAmount
-	p.TotalBet += betInfo.BetAmount
+// ExtendTimeBank는 현재 플레이어의 타임뱅크를 써서 액션 마감 시간을 늘림 (amount가 0이면 남은 타임뱅크를 모두 사용)
+func (g *GameService) ExtendTimeBank(ctx context.Context, roomId string, nickname string, amount time.Duration) (*TimerEvent, error) {
+	defer g.roomLocks.Lock(roomId)()
 
-	nextPlayerIdx, err := game.GetNextPlayerIdx()
+	game, err := g.GetGame(ctx, roomId)
 	if err != nil {
-		return "", false, 0, 0, 0, 0, false, err
+		return nil, err
 	}
 
-	nextPlayerName := game.Players[nextPlayerIdx].Nickname
-	currentPlayerIdx, err := getPlayerIdx(game.Players, p.Nickname)
-	if err != nil {
-		return "", false, 0, 0, 0, 0, false, err
+	if _, err := game.ExtendTimeBank(nickname, amount); err != nil {
+		return nil, err
 	}
 
-	// 현재 베팅한 플레이어가 베팅한 금액에 따라 베팅리더인지 체크 후에 현재 베팅 턴을 종료할지 결정
-	// (현재 플레이어가 베팅리더가 아니고, betLeader 이전 플레이어면 플레이어들의 베팅이 종료됨)
-	// 베팅이 종료되면 다음 베팅을 위해서 player들의 currentBet을 초기화시켜주어야함
-	if betInfo.BetAmount > game.CurrentBet { // 현재 플레이어가 베팅 리더가 되는 경우
-		game.CurrentBet = p.CurrentBet
-		game.BetLeaderIdx = currentPlayerIdx
-		game.CurrentPlayerIdx = nextPlayerIdx
-		return nextPlayerName, false, p.CurrentBet, p.TotalBet, game.CurrentBet, game.TotalBet, false, nil
-	} else {
-		// 베팅 종료 조건 달성한 경우
-		if getReadyPlayerIdx(game.Players, currentPlayerIdx+1) == game.BetLeaderIdx {
-			game.CurrentPlayerIdx = game.FirstPlayerIdx // 다음 베팅을 위해서 초기화
-			game.ClearPlayersCurrentBet()
-			if err := g.saveGame(ctx, game.RoomId.String(), game); err != nil {
-				return "", false, 0, 0, 0, 0, false, err
-			}
-			return nextPlayerName, false, p.CurrentBet, p.TotalBet, game.CurrentBet, game.TotalBet, true, nil
-		}
+	if err := g.saveGame(ctx, roomId, game); err != nil {
+		return nil, err
+	}
 
-		// 베팅은 종료되지 않고 다음 플레이어가 베팅해야함
-		game.CurrentPlayerIdx = nextPlayerIdx
+	event := g.shotClock.Start(roomId, game)
+	return event, nil
+}
 
-		if err := g.saveGame(ctx, game.RoomId.String(), game); err != nil {
-			return "", false, 0, 0, 0, 0, false, err
-		}
+// ResumeShotClock은 저장된 마감 시간으로 방의 타이머를 다시 시작함
+// 서버가 재시작되어 타이머가 사라진 경우 플레이어가 다시 접속할 때 호출함 (이미 지난 마감 시간이면 바로 처리됨)
+func (g *GameService) ResumeShotClock(ctx context.Context, roomId string) (*TimerEvent, error) {
+	defer g.roomLocks.Lock(roomId)()
 
-		return nextPlayerName, false, p.CurrentBet, p.TotalBet, game.CurrentBet, game.TotalBet, false, nil
+	game, err := g.GetGame(ctx, roomId)
+	if err != nil {
+		return nil, err
 	}
-}
-
-func (g *GameService) distributeMoneyToWinners(game *entity.Game) (winners []*entity.Player, losers []*entity.Player) {
-	winners, losers, err := game.GetWinnersAndLosers(); if err != nil {
+	if g.shotClock.IsRunning(roomId) {
 		return nil, nil
 	}
+	return g.shotClock.Start(roomId, game), nil
+}
 
-	if len(winners) == 1 {
-		winner := winners[0]
-		winner.GameBalance += game.TotalBet - winner.TotalBet
-		winner.TotalBalance += game.TotalBet - winner.TotalBet
-		
-		for _, loser := range losers {
-			loser.GameBalance -= loser.TotalBet
-			loser.TotalBalance -= loser.TotalBet
-		}
+// applyAction은 액션을 처리하고 그 결과를 응답으로 만듬
+// 다음 스트릿으로 넘기거나 핸드를 정산하는 규칙은 모두 entity.Game.Apply에 있음
+func (g *GameService) applyAction(ctx context.Context, game *entity.Game, nickname string, action entity.Action) (*BetResponse, error) {
+	// 런아웃이면 보드를 깔기 전의 상태를 먼저 보여줘야하므로 저장해둠
+	phase, board := game.Phase, append([]card.Card(nil), game.Board...)
 
-		return winners, losers
+	result, err := game.Apply(nickname, action)
+	if err != nil {
+		return nil, err 
 	}
+	return g.applyResult(ctx, game, nickname, result, phase, board)
+}
 
-	// 승자가 여러명인 경우 
-	// 단순히 1/N 하면 안되고 중간에 올인여부를 판단해야함 
+// applyResult는 Apply나 Decide의 결과를 응답으로 만들고 저장함
+// 잔고 반영이나 저장이 실패하면 Apply나 Decide가 찍어둔 스냅샷으로 게임을 처리 전으로 되돌림
+// 쇼다운에서 공개된 핸드는 방의 모든 플레이어에게 보냄
+func (g *GameService) applyResult(ctx context.Context, game *entity.Game, nickname string, result *entity.ApplyResult, phase entity.Phase, board []card.Card) (*BetResponse, error) {
+	var betResponse BetResponse
+	roomId := game.RoomId.String()
 
-	var tmpWinners []*entity.Player
-	// 첫번째 winner를 우선 추가해둠 
-	tmpWinners = append(tmpWinners, winners[0])
+	p := game.FindPlayer(nickname)
 
-	for i:=1; i<len(winners); i++ {
-		prevWinner, curWinner := winners[i-1], winners[i]
+	betResponse.Action = result.Action.Type
+	betResponse.IsBetEnd = result.IsRoundEnd
+	betResponse.IsPlayerDead = p.IsDead
+	betResponse.PlayerCurrentBet = p.CurrentBet
+	betResponse.PlayerTotalBet = p.TotalBet
+	betResponse.GameCurrentBet = game.CurrentBet
+	betResponse.GameTotalBet = game.TotalBet
+	betResponse.Phase = result.Phase
+	betResponse.Board = card.CompactCards(game.Board)
+
+	// 더 이상 베팅할 상대가 없어서 남은 보드를 자동으로 깔고 쇼다운함
+	if len(result.Runout) > 0 {
+		if err := g.runOut(ctx, game, &betResponse, result, phase, board); err != nil {
+			game.Undo()
+			return nil, err
+		}
+		g.publishShowdown(roomId, &betResponse)
+		return &betResponse, nil
+	}
 
-		// winners는 totalBet을 기준으로 오름차순 정렬되어있으므로
-		// prevWinner와 curWinner의 totalBet이 다르다면 prevWinner는 중간에 올인을 한 것이고 curWinner는 더 베팅을 한 플레이어임
-		if prevWinner.TotalBet != curWinner.TotalBet {
-			winnerReward := uint64((len(losers) * int(prevWinner.TotalBet)) / len(tmpWinners))
+	if result.Phase == entity.PhaseHandComplete {
+		if err := g.finishHand(ctx, game, &betResponse, result); err != nil {
+			game.Undo()
+			return nil, err
+		}
+		g.publishShowdown(roomId, &betResponse)
+		return &betResponse, nil
+	}
 
-			for _, winner := range tmpWinners {
-				winner.GameBalance += winnerReward
-				winner.TotalBalance += winnerReward
-			}
+	nextPlayer := game.Players[game.CurrentPlayerIdx]
+	betResponse.NextPlayerName = nextPlayer.Nickname
+	if result.Phase == entity.PhaseShowdown {
+		// 다음 플레이어가 핸드를 공개할지 버릴지 정할 때까지 지금까지 공개된 핸드를 알려줌
+		betResponse.Showdown = NewShowdownReveals(game, result.Reveals)
+	} else {
+		// 다음 플레이어와 그 플레이어가 할 수 있는 액션들을 함께 알려줌
+		betResponse.LegalActions = game.LegalActions(nextPlayer)
+	}
 
-			// 올인한사람에게 돈을 줬으므로 나머지 winner에서 돈 빼줘야함 
-			for j:=i; j<len(winners); j++ {
-				winners[i].GameBalance -= winnerReward
-				winners[i].TotalBalance -= winnerReward
-			}
+	if err := g.saveGame(ctx, roomId, game); err != nil {
+		game.Undo()
+		return nil, err
+	}
+	g.publishShowdown(roomId, &betResponse)
 
-			tmpWinners = nil 
-		} else {
-			tmpWinners = append(tmpWinners, curWinner)
-		}
+	// 다음 플레이어의 타이머를 시작함
+	g.shotClock.Start(roomId, game)
+	return &betResponse, nil 
+}
+
+// finishHand는 끝난 핸드의 결과를 응답에 담고 잔고를 반영한 뒤 다음 핸드를 위해 게임을 초기화함
+// 폴드로 끝난 경우 (쇼다운이 없었으면) 승자의 카드를 공개하지 않음
+// 잔고를 반영한 후 이벤트 로그에 추가하지 못하면 잔고도 핸드 전으로 되돌림 (게임은 호출한 곳에서 Undo로 되돌림)
+func (g *GameService) finishHand(ctx context.Context, game *entity.Game, betResponse *BetResponse, result *entity.ApplyResult) error {
+	betResponse.IsUncontested = result.Reveals == nil
+
+	// 마지막 공격자부터 순서대로 공개하거나 버린 핸드 중 공개한 핸드만 보냄
+	if result.Reveals != nil {
+		betResponse.Showdown = NewShowdownReveals(game, result.Reveals)
 	}
 
-	if len(tmpWinners) > 0 {
-		for _, winner := range tmpWinners {
-			winnerReward := uint64((len(losers) * int(winner.TotalBet)) / len(tmpWinners))
-			winner.GameBalance += winnerReward
-			winner.TotalBalance += winnerReward
-		}
+	// 메인팟과 사이드팟은 이미 정산되었으므로 승자와 패자 잔고를 업데이트
+	betResponse.Pots = NewPotResponses(result.Pots)
+	winnersAndLosers := append(result.Winners, result.Losers...)
+	if err := g.updatePlayersBalance(ctx, winnersAndLosers...); err != nil {
+		return err
 	}
 
-	for _, loser := range losers {
-		loser.GameBalance -= loser.TotalBet
-		loser.TotalBalance -= loser.TotalBet
+	var winnersName []string
+	for _, p := range result.Winners {
+		winnersName = append(winnersName, p.Nickname)
 	}
+	betResponse.Winners = winnersName
+
+	// 새 시드를 만들기 전에 이번 핸드의 ServerSeed를 공개
+	betResponse.Fairness = game.GetFairnessProof()
+
+	// 게임 초기화
+	game.InitGame()
 
-	return winners, losers
+	if err := g.saveGame(ctx, game.RoomId.String(), game); err != nil {
+		g.restorePlayersBalance(ctx, game, winnersAndLosers)
+		return err
+	}
+	g.shotClock.Stop(game.RoomId.String())
+	return nil
 }
 
 func (g *GameService) updatePlayersBalance(ctx context.Context, players ...*entity.Player) error {
@@ -361,13 +528,29 @@ func (g *GameService) updatePlayersBalance(ctx context.Context, players ...*enti
 		userIdWithBalances = append(userIdWithBalances, repository.NewUserIdWithBalance(p.Id, p.TotalBalance))
 	}
 
+	// 실패하면 UpdateMultipleBalance 안에서 모두 롤백되고 게임은 호출한 곳에서 되돌림
 	if err := g.userRepo.UpdateMultipleBalance(ctx, userIdWithBalances); err != nil {
-		// 실패시 롤백
-		for _, p := range players {
-			p.Undo()
-		}
 		return err 
 	}
 
 	return nil 
+}
+
+// restorePlayersBalance는 이미 반영한 players의 잔고를 게임 스냅샷에 있는 핸드 전의 잔고로 되돌림
+func (g *GameService) restorePlayersBalance(ctx context.Context, game *entity.Game, players []*entity.Player) {
+	if game.Memento == nil {
+		return
+	}
+
+	snapshot := *game
+	snapshot.Undo()
+	var restored []*entity.Player
+	for _, p := range players {
+		if before := snapshot.FindPlayer(p.Nickname); before != nil {
+			restored = append(restored, before)
+		}
+	}
+	if err := g.updatePlayersBalance(ctx, restored...); err != nil {
+		fmt.Println("RestoreBalanceErr: ", err.Error())
+	}
 }
\ No newline at end of file
@@ -0,0 +1,492 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PudgeKim/go-holdem/domain/entity"
+	"github.com/PudgeKim/go-holdem/domain/repository"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+	"github.com/PudgeKim/go-holdem/persistence"
+	"github.com/google/uuid"
+)
+
+var (
+	errInjected = errors.New("injected repository error")
+	errNoGame   = errors.New("game doesn't exist")
+)
+
+type fakeUserRepo struct {
+	balances  map[int64]uint64
+	updateErr error
+}
+
+func (f *fakeUserRepo) FindOne(ctx context.Context, id int64) (*entity.User, error) {
+	return nil, nil
+}
+
+func (f *fakeUserRepo) FindByNickname(ctx context.Context, nickname string) (*entity.User, error) {
+	return nil, nil
+}
+
+func (f *fakeUserRepo) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
+	return nil, nil
+}
+
+func (f *fakeUserRepo) Save(ctx context.Context, user *entity.User) error {
+	return nil
+}
+
+func (f *fakeUserRepo) UpdateBalance(ctx context.Context, userId int64, balance uint64) (uint64, error) {
+	f.balances[userId] = balance
+	return balance, nil
+}
+
+func (f *fakeUserRepo) UpdateMultipleBalance(ctx context.Context, userIdWithBalances []repository.UserIdWithBalance) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	for _, u := range userIdWithBalances {
+		f.balances[u.UserId] = u.Balance
+	}
+	return nil
+}
+
+// redis처럼 게임을 JSON으로 저장함
+type fakeGameRepo struct {
+	games   map[string][]byte
+	saveErr error
+}
+
+func (f *fakeGameRepo) GetGame(ctx context.Context, roomId string) (*entity.Game, error) {
+	data, ok := f.games[roomId]
+	if !ok {
+		return nil, errNoGame
+	}
+	var game entity.Game
+	if err := game.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &game, nil
+}
+
+func (f *fakeGameRepo) SaveGame(ctx context.Context, roomId string, game *entity.Game) error {
+	if f.saveErr != nil {
+		return f.saveErr
+	}
+	data, err := game.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	f.games[roomId] = data
+	return nil
+}
+
+func (f *fakeGameRepo) CreateGame(ctx context.Context, hostPlayer *entity.Player, minBetAmount uint64, opts ...entity.GameOption) (*entity.Game, string, error) {
+	roomId := uuid.New()
+	return entity.NewGame(roomId, 7, hostPlayer, minBetAmount, opts...), roomId.String(), nil
+}
+
+func (f *fakeGameRepo) DeleteGame(ctx context.Context, roomId string) error {
+	delete(f.games, roomId)
+	return nil
+}
+
+func (f *fakeGameRepo) FindPlayer(ctx context.Context, roomId string, nickname string) (*entity.Player, error) {
+	return nil, nil
+}
+
+// faultyEventRepo는 실제로 쓰이는 메모리 이벤트 저장소에 실패와 지연을 넣을 수 있게 감쌈
+type faultyEventRepo struct {
+	repository.GameEventRepository
+	appendErr error
+	latency   time.Duration // 이벤트를 읽어온 후 응답이 오기까지 걸리는 시간 (동시에 들어온 요청들이 겹치게 하기 위해 사용)
+}
+
+func (f *faultyEventRepo) AppendEvents(ctx context.Context, roomId string, events ...entity.Event) error {
+	if f.appendErr != nil {
+		return f.appendErr
+	}
+	return f.GameEventRepository.AppendEvents(ctx, roomId, events...)
+}
+
+func (f *faultyEventRepo) GetEvents(ctx context.Context, roomId string, fromSeq uint64) ([]entity.Event, error) {
+	events, err := f.GameEventRepository.GetEvents(ctx, roomId, fromSeq)
+	time.Sleep(f.latency)
+	return events, err
+}
+
+// storedEvents는 방의 이벤트 로그 전체를 리턴함
+func (f *faultyEventRepo) storedEvents(t *testing.T, roomId string) []entity.Event {
+	events, err := f.GameEventRepository.GetEvents(context.Background(), roomId, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+// p0, p1, p2가 1000씩 들고 앉아서 핸드를 시작한 방을 만듦 (p0이 SmallBlind 10, p1이 BigBlind 20, p2가 첫 플레이어)
+func newTestGameService(t *testing.T) (*GameService, *fakeUserRepo, *fakeGameRepo, *faultyEventRepo, string) {
+	ctx := context.Background()
+	userRepo := &fakeUserRepo{balances: map[int64]uint64{0: 10000, 1: 10000, 2: 10000}}
+	gameRepo := &fakeGameRepo{games: make(map[string][]byte)}
+	eventRepo := &faultyEventRepo{GameEventRepository: persistence.NewMemoryGameEventRepository()}
+	gameService := NewGameService(userRepo, gameRepo, eventRepo, nil)
+
+	host := &entity.User{Id: 0, Nickname: "p0", Balance: 10000}
+	game, err := gameService.CreateGame(ctx, host, 1000, 10, entity.WithActionTimeout(0), entity.WithRunoutDelay(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	roomId := game.RoomId.String()
+	for i := int64(1); i < 3; i++ {
+		user := &entity.User{Id: i, Nickname: fmt.Sprintf("p%d", i), Balance: 10000}
+		if err := gameService.AddUserToGame(ctx, roomId, user, 1000); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		if err := gameService.HandleReady(ctx, roomId, fmt.Sprintf("p%d", i), true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := gameService.StartGame(ctx, roomId, "p0"); err != nil {
+		t.Fatal(err)
+	}
+	return gameService, userRepo, gameRepo, eventRepo, roomId
+}
+
+func TestBetRollback(t *testing.T) {
+	steps := []BetInfo{
+		{PlayerName: "p2", Action: entity.Raise, BetAmount: 60},
+		{PlayerName: "p0", Action: entity.Fold},
+		{PlayerName: "p1", Action: entity.Fold}, // 핸드가 끝나고 잔고가 반영됨
+	}
+
+	tests := []struct {
+		name      string
+		failAt    int  // 몇번째 액션에서 저장소가 실패하는지
+		committed bool // 이벤트 로그에는 추가되어서 액션이 처리된 것으로 보는지
+		inject    func(userRepo *fakeUserRepo, gameRepo *fakeGameRepo, eventRepo *faultyEventRepo, err error)
+	}{
+		{"balance update fails", 2, false, func(u *fakeUserRepo, g *fakeGameRepo, e *faultyEventRepo, err error) { u.updateErr = err }},
+		{"event log append fails after balance update", 2, false, func(u *fakeUserRepo, g *fakeGameRepo, e *faultyEventRepo, err error) { e.appendErr = err }},
+		{"event log append fails during hand", 1, false, func(u *fakeUserRepo, g *fakeGameRepo, e *faultyEventRepo, err error) { e.appendErr = err }},
+		{"game save fails after balance update", 2, true, func(u *fakeUserRepo, g *fakeGameRepo, e *faultyEventRepo, err error) { g.saveErr = err }},
+		{"game save fails during hand", 0, true, func(u *fakeUserRepo, g *fakeGameRepo, e *faultyEventRepo, err error) { g.saveErr = err }},
+	}
+
+	for _, tt := range tests {
+		ctx := context.Background()
+		gameService, userRepo, gameRepo, eventRepo, roomId := newTestGameService(t)
+
+		for i, step := range steps {
+			if i != tt.failAt {
+				if _, err := gameService.Bet(ctx, roomId, step); err != nil {
+					t.Fatalf("%s: step %d: %s", tt.name, i, err.Error())
+				}
+				continue
+			}
+
+			saved := gameRepo.games[roomId]
+			logged := len(eventRepo.storedEvents(t, roomId))
+			balances := map[int64]uint64{0: 10000, 1: 10000, 2: 10000}
+
+			tt.inject(userRepo, gameRepo, eventRepo, errInjected)
+			_, err := gameService.Bet(ctx, roomId, step)
+			tt.inject(userRepo, gameRepo, eventRepo, nil)
+
+			if tt.committed {
+				// 저장된 게임은 뒤처지지만 이벤트 로그에는 액션이 남아있으므로 다시 보내지 않음
+				if err != nil {
+					t.Fatalf("%s: action should be committed to the event log but got %s", tt.name, err.Error())
+				}
+				if string(gameRepo.games[roomId]) != string(saved) {
+					t.Errorf("%s: saved game should be left behind the event log", tt.name)
+				}
+				if len(eventRepo.storedEvents(t, roomId)) == logged {
+					t.Errorf("%s: event log should have the action", tt.name)
+				}
+				continue
+			}
+
+			// 저장된 게임, 이벤트 로그, 잔고 모두 액션 전과 같아야함
+			if err != errInjected {
+				t.Fatalf("%s: should return the injected error but got %v", tt.name, err)
+			}
+			if string(gameRepo.games[roomId]) != string(saved) {
+				t.Errorf("%s: saved game should be rolled back", tt.name)
+			}
+			if len(eventRepo.storedEvents(t, roomId)) != logged {
+				t.Errorf("%s: event log should have %d events but got %d", tt.name, logged, len(eventRepo.storedEvents(t, roomId)))
+			}
+			if !reflect.DeepEqual(userRepo.balances, balances) {
+				t.Errorf("%s: balances should be rolled back to %v but got %v", tt.name, balances, userRepo.balances)
+			}
+
+			// 실패한 액션은 다시 보내면 처리되어야함
+			if _, err := gameService.Bet(ctx, roomId, step); err != nil {
+				t.Fatalf("%s: step %d: %s", tt.name, i, err.Error())
+			}
+		}
+
+		expected := map[int64]uint64{0: 9990, 1: 9980, 2: 10030}
+		if !reflect.DeepEqual(userRepo.balances, expected) {
+			t.Errorf("%s: balances should be %v but got %v", tt.name, expected, userRepo.balances)
+		}
+
+		// 저장된 게임이 뒤처져 있어도 GetGame은 이벤트 로그 전체로 만든 게임과 같아야함
+		game, err := gameService.GetGame(ctx, roomId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		replayed, err := entity.ReplayGame(eventRepo.storedEvents(t, roomId))
+		if err != nil {
+			t.Fatalf("%s: event log should be replayable but got %s", tt.name, err.Error())
+		}
+		gameJSON, _ := game.MarshalBinary()
+		replayedJSON, _ := replayed.MarshalBinary()
+		if string(gameJSON) != string(replayedJSON) {
+			t.Errorf("%s: game should be\n%s\nbut got\n%s", tt.name, replayedJSON, gameJSON)
+		}
+	}
+}
+
+func TestGetGameRecoversFromEventLog(t *testing.T) {
+	ctx := context.Background()
+	gameService, _, gameRepo, _, roomId := newTestGameService(t)
+	if _, err := gameService.Bet(ctx, roomId, BetInfo{PlayerName: "p2", Action: entity.Call}); err != nil {
+		t.Fatal(err)
+	}
+	expected := gameRepo.games[roomId]
+
+	// 저장된 게임이 없어지면 이벤트 로그로 다시 만들어서 저장함
+	delete(gameRepo.games, roomId)
+	game, err := gameService.GetGame(ctx, roomId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := game.MarshalBinary()
+	if string(data) != string(expected) {
+		t.Errorf("recovered game should be\n%s\nbut got\n%s", expected, data)
+	}
+	if string(gameRepo.games[roomId]) != string(expected) {
+		t.Error("recovered game should be saved")
+	}
+}
+
+func TestConcurrentActions(t *testing.T) {
+	ctx := context.Background()
+	gameService, _, _, eventRepo, roomId := newTestGameService(t)
+	logged := len(eventRepo.storedEvents(t, roomId))
+	eventRepo.latency = time.Millisecond
+
+	// 같은 플레이어의 액션이 동시에 여러번 들어와도 방이 잠겨있으므로 하나만 처리되고
+	// 나머지는 바뀐 게임을 보고 규칙에 따라 거절되어야함 (이전 게임을 덮어쓰거나 StaleGame이 나오면 안됨)
+	const n = 10
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := gameService.Bet(ctx, roomId, BetInfo{PlayerName: "p2", Action: entity.Call})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		switch err {
+		case nil:
+			succeeded++
+		case gameerror.InvalidPlayerTurn:
+		default:
+			t.Errorf("other actions should return %v but got %v", gameerror.InvalidPlayerTurn, err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("only one action should succeed but got %d", succeeded)
+	}
+	if len(eventRepo.storedEvents(t, roomId)) != logged+1 {
+		t.Errorf("event log should have one more event but got %d", len(eventRepo.storedEvents(t, roomId))-logged)
+	}
+}
+
+type fakePublisher struct {
+	mu     sync.Mutex
+	events []interface{}
+}
+
+func (f *fakePublisher) Publish(ctx context.Context, subscribeChan string, event interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event)
+	return nil
+}
+
+// 마지막으로 보낸 ShowdownEvent를 리턴함
+func (f *fakePublisher) lastShowdown() *ShowdownEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := len(f.events) - 1; i >= 0; i-- {
+		if event, ok := f.events[i].(*ShowdownEvent); ok {
+			return event
+		}
+	}
+	return nil
+}
+
+func TestShowdownDecision(t *testing.T) {
+	ctx := context.Background()
+	gameService, _, _, eventRepo, roomId := newTestGameService(t)
+	publisher := &fakePublisher{}
+	gameService.publisher = publisher
+	for i := 0; i < 3; i++ {
+		if err := gameService.SetAutoMuck(ctx, roomId, fmt.Sprintf("p%d", i), false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 카드는 매번 새로 섞이므로 누군가 버릴 수 있는 핸드를 들고 쇼다운에 갈 때까지 핸드를 체크로만 진행함
+	decided := false
+	for hand := 0; hand < 20 && !decided; hand++ {
+		if hand > 0 {
+			if _, err := gameService.StartGame(ctx, roomId, "p0"); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var res *BetResponse
+		for res == nil || (res.Phase != entity.PhaseShowdown && res.Phase != entity.PhaseHandComplete) {
+			game, err := gameService.GetGame(ctx, roomId)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p := game.Players[game.CurrentPlayerIdx]
+			action := entity.Check
+			if p.CurrentBet < game.CurrentBet {
+				action = entity.Call
+			}
+			if res, err = gameService.Bet(ctx, roomId, BetInfo{PlayerName: p.Nickname, Action: action}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		for res.Phase == entity.PhaseShowdown {
+			decided = true
+
+			// 지금까지 공개된 핸드는 결정을 기다리는 동안에도 방의 모든 플레이어에게 보내야함
+			event := publisher.lastShowdown()
+			if event == nil || len(event.Result.Showdown) == 0 || event.Result.NextPlayerName != res.NextPlayerName {
+				t.Fatalf("revealed hands should be published while waiting for %s but got %+v", res.NextPlayerName, event)
+			}
+			if len(res.LegalActions) != 0 {
+				t.Errorf("there should be no betting actions during the showdown but got %v", res.LegalActions)
+			}
+			if _, err := gameService.Bet(ctx, roomId, BetInfo{PlayerName: res.NextPlayerName, Action: entity.Check}); err != gameerror.GameNotStarted {
+				t.Errorf("betting during the showdown should return %v but got %v", gameerror.GameNotStarted, err)
+			}
+
+			var err error
+			if res, err = gameService.Decide(ctx, roomId, res.NextPlayerName, entity.Muck); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if res.Phase != entity.PhaseHandComplete || len(res.Winners) == 0 || len(res.Showdown) != 3 {
+			t.Fatalf("hand should be settled with 3 reveals but got %s with %d reveals", res.Phase, len(res.Showdown))
+		}
+		if event := publisher.lastShowdown(); event == nil || event.Result.Phase != entity.PhaseHandComplete {
+			t.Errorf("showdown result should be published but got %+v", event)
+		}
+	}
+	if !decided {
+		t.Fatal("no player was asked to show or muck")
+	}
+
+	game, err := gameService.GetGame(ctx, roomId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed, err := entity.ReplayGame(eventRepo.storedEvents(t, roomId))
+	if err != nil {
+		t.Fatalf("event log should be replayable but got %s", err.Error())
+	}
+	gameJSON, _ := game.MarshalBinary()
+	replayedJSON, _ := replayed.MarshalBinary()
+	if string(gameJSON) != string(replayedJSON) {
+		t.Errorf("game should be\n%s\nbut got\n%s", replayedJSON, gameJSON)
+	}
+}
+
+func TestLeaveGame(t *testing.T) {
+	ctx := context.Background()
+	gameService, userRepo, _, eventRepo, roomId := newTestGameService(t)
+
+	// 차례인 플레이어가 나가면 폴드한 것으로 보고 다음 플레이어에게 차례가 넘어감
+	res, err := gameService.LeaveGame(ctx, roomId, "p2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil || res.Action != entity.Fold || !res.IsPlayerDead || res.NextPlayerName != "p0" {
+		t.Fatalf("p2 should fold and p0 should be next but got %+v", res)
+	}
+	if _, err := gameService.LeaveGame(ctx, roomId, "p2"); err != gameerror.NoPlayerExists {
+		t.Errorf("leaving twice should return %v but got %v", gameerror.NoPlayerExists, err)
+	}
+
+	// 차례가 아닌 플레이어가 나가도 핸드는 계속되고 낸 블라인드는 잃음
+	if res, err = gameService.LeaveGame(ctx, roomId, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if res.Phase != entity.PhaseHandComplete {
+		t.Fatalf("hand should be over when only p0 is left but got %s", res.Phase)
+	}
+	expected := map[int64]uint64{0: 10020, 1: 9980, 2: 10000}
+	if !reflect.DeepEqual(userRepo.balances, expected) {
+		t.Errorf("balances should be %v but got %v", expected, userRepo.balances)
+	}
+
+	// 핸드가 끝나면 나간 플레이어들의 자리가 비워짐
+	game, err := gameService.GetGame(ctx, roomId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(game.Players) != 1 || game.FindPlayer("p0") == nil {
+		t.Fatalf("only p0 should be left but got %d players", len(game.Players))
+	}
+
+	// 핸드 중이 아니면 바로 자리가 비워지고 다시 앉을 수 있음
+	user := &entity.User{Id: 1, Nickname: "p1", Balance: 9980}
+	if err := gameService.AddUserToGame(ctx, roomId, user, 1000); err != nil {
+		t.Fatal(err)
+	}
+	if res, err = gameService.LeaveGame(ctx, roomId, "p1"); err != nil || res != nil {
+		t.Fatalf("leaving between hands should return no result but got %+v, %v", res, err)
+	}
+	if err := gameService.AddUserToGame(ctx, roomId, user, 1000); err != nil {
+		t.Errorf("p1 should be able to sit again but got %v", err)
+	}
+
+	game, err = gameService.GetGame(ctx, roomId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed, err := entity.ReplayGame(eventRepo.storedEvents(t, roomId))
+	if err != nil {
+		t.Fatalf("event log should be replayable but got %s", err.Error())
+	}
+	gameJSON, _ := game.MarshalBinary()
+	replayedJSON, _ := replayed.MarshalBinary()
+	if string(gameJSON) != string(replayedJSON) {
+		t.Errorf("game should be\n%s\nbut got\n%s", replayedJSON, gameJSON)
+	}
+}
@@ -1,31 +1,129 @@
 package service
 
+import (
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/domain/entity"
+)
+
 // 베팅 관련 처리를 한 후 프론트로 베팅처리결과 전달
 type BetResponse struct {
 	Error            error  `json:"error"`
+	Action           entity.ActionType `json:"action"` // 실제로 처리된 액션 (스택을 모두 낸 경우 AllIn)
 	IsBetEnd         bool   `json:"is_bet_end"` // true면 플레이어들의 베팅이 모두 끝나서 다음 턴으로 넘어감
 	IsPlayerDead     bool   `json:"is_player_dead"`
 	PlayerCurrentBet uint64 `json:"player_current_bet"`
 	PlayerTotalBet   uint64 `json:"player_total_bet"`
 	GameCurrentBet   uint64 `json:"game_current_bet"`
 	GameTotalBet     uint64 `json:"game_total_bet"`
-	NextPlayerName   string `json:"next_player_name"`
-	GameStatus       string `json:"game_status"` // FreeFlop, Flop, Turn, River
+	NextPlayerName   string `json:"next_player_name"` // Phase가 Showdown이면 핸드를 공개할지 버릴지 정해야하는 플레이어
+	LegalActions     []entity.LegalAction `json:"legal_actions,omitempty"` // 다음 플레이어가 할 수 있는 액션과 금액 범위
+	Phase            entity.Phase `json:"phase"` // 액션을 처리한 후의 페이즈 (Preflop, Flop, Turn, River, Showdown, HandComplete)
+	Board            card.CompactCards `json:"board"` // 지금까지 깔린 커뮤니티 카드 (예: ["As","Kd","7c"])
 	Winners 		[]string `json:"winners,omitempty"`
+	IsUncontested    bool   `json:"is_uncontested,omitempty"` // true면 다른 플레이어가 모두 폴드해서 쇼다운 없이 핸드가 끝남
+	IsRunout         bool   `json:"is_runout,omitempty"` // true면 올인으로 베팅이 끝나서 남은 보드를 자동으로 깔아줌 (runout_street, runout_end 이벤트 참고)
+	Pots             []PotResponse `json:"pots,omitempty"` // 0번이 메인팟, 나머지는 사이드팟
+	Showdown         []ShowdownHand `json:"showdown,omitempty"` // 쇼다운에서 공개한 순서대로 플레이어들의 패와 족보 설명 (버린 핸드는 카드 없이 Mucked만 표시)
+	Fairness         *entity.FairnessProof `json:"fairness,omitempty"` // 핸드가 끝나면 ServerSeed를 공개함
+}
+
+type PotResponse struct {
+	Amount  uint64   `json:"amount"`
+	Winners []string `json:"winners"`
+}
+
+func NewPotResponses(pots []entity.Pot) []PotResponse {
+	responses := make([]PotResponse, len(pots))
+	for i, pot := range pots {
+		responses[i].Amount = pot.Amount
+		for _, p := range pot.Winners {
+			responses[i].Winners = append(responses[i].Winners, p.Nickname)
+		}
+	}
+	return responses
+}
+
+// 쇼다운에서 공개된 플레이어의 핸드
+type ShowdownHand struct {
+	Nickname     string                 `json:"nickname"`
+	Mucked       bool                   `json:"mucked,omitempty"` // true면 핸드를 버려서 카드를 보내지 않음
+	Hands        card.CompactCards      `json:"hands"`
+	BestCards    card.CompactCards      `json:"best_cards,omitempty"` // 보드까지 합쳐서 가장 좋은 5장
+	Descriptions map[card.Locale]string `json:"descriptions,omitempty"` // 예: {"en": "Full House, Kings full of Threes", "ko": "풀하우스 (K 풀 3)"}
+}
+
+func NewShowdownHands(players []*entity.Player) []ShowdownHand {
+	showdown := make([]ShowdownHand, 0, len(players))
+	for _, p := range players {
+		hand := ShowdownHand{
+			Nickname: p.Nickname,
+			Hands:    card.CompactCards(p.Hands),
+			BestCards: card.CompactCards(p.BestCards),
+		}
+		if p.HandValue != 0 {
+			hand.Descriptions = map[card.Locale]string{
+				card.English: p.HandValue.Describe(card.English),
+				card.Korean:  p.HandValue.Describe(card.Korean),
+			}
+		}
+		showdown = append(showdown, hand)
+	}
+	return showdown
+}
+
+// NewShowdownReveals는 쇼다운 순서대로 공개한 핸드만 카드와 족보를 담고 버린 핸드는 닉네임만 담음
+func NewShowdownReveals(game *entity.Game, reveals []entity.ShowdownReveal) []ShowdownHand {
+	showdown := make([]ShowdownHand, 0, len(reveals))
+	for _, reveal := range reveals {
+		if reveal.Decision == entity.Muck {
+			showdown = append(showdown, ShowdownHand{Nickname: reveal.Nickname, Mucked: true})
+			continue
+		}
+		showdown = append(showdown, NewShowdownHands([]*entity.Player{game.FindPlayer(reveal.Nickname)})...)
+	}
+	return showdown
 }
 
 type GameStartResponse struct {
 	ReadyPlayers []string `json:"ready_players"`
 	FirstPlayer string `json:"first_player"`
-	SmallBlind string `json:"small_blind"`
+	Button string `json:"button,omitempty"` // 버튼이 빈 자리에 놓인 경우 (dead button) 비어있음
+	ButtonSeat int `json:"button_seat"`
+	SmallBlind string `json:"small_blind"` // dead small blind면 비어있음
 	BigBlind string `json:"big_blind"`
+	SmallBlindAmount uint64 `json:"small_blind_amount"` // SmallBlind가 실제로 낸 금액 (숏스택이면 정해진 금액보다 적을 수 있음)
+	BigBlindAmount uint64 `json:"big_blind_amount"`
+	Ante uint64 `json:"ante"`
+	Pot uint64 `json:"pot"` // 블라인드와 앤티를 모두 합친 금액
+	AllInPlayers []string `json:"all_in_players,omitempty"` // 블라인드나 앤티를 내다가 올인된 플레이어들
+	SeedCommitment string `json:"seed_commitment"`
+	ClientSeeds []entity.ClientSeed `json:"client_seeds"` // 이번 핸드의 덱을 섞는데 사용된 시드들
+	LegalActions []entity.LegalAction `json:"legal_actions,omitempty"` // 첫 플레이어가 할 수 있는 액션과 금액 범위
+	Runout *BetResponse `json:"runout,omitempty"` // 블라인드와 앤티만으로 모두 올인되어 바로 런아웃한 경우
 }
 
-func NewGameStartResponse(readyPlayers []string, firstPlayer, smallBlind, bigBlind string) *GameStartResponse {
+func NewGameStartResponse(readyPlayers []string, firstPlayer, smallBlind, bigBlind string, smallBlindAmount, bigBlindAmount, ante, pot uint64, allInPlayers []string, seedCommitment string, clientSeeds []entity.ClientSeed) *GameStartResponse {
 	return &GameStartResponse{
 		readyPlayers,
 		firstPlayer,
+		"",
+		entity.NoSeat,
 		smallBlind,
 		bigBlind,
+		smallBlindAmount,
+		bigBlindAmount,
+		ante,
+		pot,
+		allInPlayers,
+		seedCommitment,
+		clientSeeds,
+		nil,
+		nil,
 	}
+}
+
+// 다음 핸드의 ServerSeed에 대한 commitment
+// 클라이언트는 시드를 보내기 전에 이 값을 저장해두고 핸드가 끝난 후 공개된 ServerSeed와 비교함
+type SeedResponse struct {
+	SeedCommitment string `json:"seed_commitment"`
 }
\ No newline at end of file
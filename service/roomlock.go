@@ -0,0 +1,40 @@
+package service
+
+import "sync"
+
+// RoomLocks는 같은 방의 게임을 가져와서 바꾸고 저장하는 요청들이 한번에 하나씩만 실행되게 함
+// 웹소켓 요청과 ShotClock의 자동 액션처럼 같은 방을 동시에 바꾸는 요청이 서로의 변경을 덮어쓰지 않도록
+// 게임을 바꾸는 GameService의 메서드는 모두 GetGame 전에 방을 잠가야함
+// (다른 서버가 같은 방을 바꾸는 경우는 이벤트 로그의 Seq 확인으로 막음, GameEventRepository 참고)
+type RoomLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func NewRoomLocks() *RoomLocks {
+	return &RoomLocks{
+		locks: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock은 방을 잠그고 잠금을 푸는 함수를 리턴함
+func (r *RoomLocks) Lock(roomId string) func() {
+	r.mu.Lock()
+	lock, ok := r.locks[roomId]
+	if !ok {
+		lock = &sync.Mutex{}
+		r.locks[roomId] = lock
+	}
+	r.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// Remove는 삭제된 방의 잠금을 지움
+func (r *RoomLocks) Remove(roomId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.locks, roomId)
+}
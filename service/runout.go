@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/domain/entity"
+	"github.com/PudgeKim/go-holdem/domain/repository"
+)
+
+const (
+	RunoutStreet = "runout_street" // 올인 런아웃에서 다음 스트릿이 깔림
+	RunoutEnd    = "runout_end"    // 런아웃이 끝나고 쇼다운 결과가 나옴
+)
+
+// RunoutEvent는 올인 런아웃에서 스트릿을 하나씩 보여주기 위해 방의 클라이언트들에게 보내는 이벤트
+type RunoutEvent struct {
+	Type   string            `json:"type"`
+	RoomId string            `json:"room_id"`
+	Phase  entity.Phase      `json:"phase"` // Flop, Turn, River, HandComplete
+	Board  card.CompactCards `json:"board"`
+	Result *BetResponse      `json:"result,omitempty"` // RunoutEnd일 때 쇼다운과 정산 결과
+}
+
+// Runout은 이미 정산이 끝난 런아웃의 스트릿들을 일정한 간격으로 방에 보냄
+// 게임 상태는 런아웃을 시작할 때 모두 계산되어 저장되므로 여기서는 보여주는 속도만 조절함
+type Runout struct {
+	publisher repository.EventPublisher
+
+	mu      sync.Mutex
+	running map[string]bool // 이벤트를 보내는 중인 roomId들 (끝나기 전에는 다음 핸드를 시작하지 않음)
+}
+
+func NewRunout(publisher repository.EventPublisher) *Runout {
+	return &Runout{
+		publisher: publisher,
+		running:   make(map[string]bool),
+	}
+}
+
+// Start는 delay마다 events를 하나씩 보냄
+func (r *Runout) Start(roomId string, delay time.Duration, events []*RunoutEvent) {
+	r.mu.Lock()
+	r.running[roomId] = true
+	r.mu.Unlock()
+
+	go r.run(roomId, delay, events)
+}
+
+func (r *Runout) IsRunning(roomId string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.running[roomId]
+}
+
+func (r *Runout) run(roomId string, delay time.Duration, events []*RunoutEvent) {
+	defer func() {
+		r.mu.Lock()
+		delete(r.running, roomId)
+		r.mu.Unlock()
+	}()
+
+	for _, event := range events {
+		time.Sleep(delay)
+		r.publish(roomId, event)
+	}
+}
+
+func (r *Runout) publish(roomId string, event *RunoutEvent) {
+	if r.publisher == nil {
+		return
+	}
+	if err := r.publisher.Publish(context.Background(), getSubscribeChan(roomId), event); err != nil {
+		fmt.Println("RunoutPublishErr: ", err.Error())
+	}
+}
+
+// runOut은 Apply나 RunOut으로 남은 보드를 모두 깔고 정산한 핸드를 클라이언트들에게 보여줌
+// 남은 플레이어들의 홀카드는 betResponse에 바로 담아 공개하고 (올인 쇼다운이므로 모두 공개해야함)
+// RunoutDelay가 있으면 스트릿들과 결과는 그 간격으로 이벤트를 통해 보냄 (0이면 betResponse에 결과를 바로 담음)
+// phase와 board는 런아웃을 시작하기 전의 페이즈와 보드
+func (g *GameService) runOut(ctx context.Context, game *entity.Game, betResponse *BetResponse, result *entity.ApplyResult, phase entity.Phase, board []card.Card) error {
+	roomId := game.RoomId.String()
+	delay := game.RunoutDelay
+	betResponse.IsRunout = true
+
+	// 보드와 족보는 아직 보여주지 않고 홀카드만 공개함 (InitGame에서 카드가 지워지므로 정산 전에 만들어둠)
+	holeCards := make([]ShowdownHand, 0, len(result.Reveals))
+	for _, reveal := range result.Reveals {
+		holeCards = append(holeCards, ShowdownHand{
+			Nickname: reveal.Nickname,
+			Hands:    card.CompactCards(game.FindPlayer(reveal.Nickname).Hands),
+		})
+	}
+
+	final := *betResponse
+	final.Phase = result.Phase
+	final.Board = card.CompactCards(game.Board)
+	if err := g.finishHand(ctx, game, &final, result); err != nil {
+		return err
+	}
+
+	if delay == 0 {
+		*betResponse = final
+		return nil
+	}
+
+	betResponse.Phase = phase
+	betResponse.Board = card.CompactCards(board)
+	betResponse.Showdown = holeCards
+
+	events := make([]*RunoutEvent, 0, len(result.Runout)+1)
+	for _, street := range result.Runout {
+		events = append(events, &RunoutEvent{
+			Type:   RunoutStreet,
+			RoomId: roomId,
+			Phase:  street.Phase,
+			Board:  card.CompactCards(street.Board),
+		})
+	}
+	events = append(events, &RunoutEvent{
+		Type:   RunoutEnd,
+		RoomId: roomId,
+		Phase:  final.Phase,
+		Board:  final.Board,
+		Result: &final,
+	})
+	g.runout.Start(roomId, delay, events)
+	return nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/PudgeKim/go-holdem/domain/entity"
+	"github.com/PudgeKim/go-holdem/domain/repository"
+	"github.com/PudgeKim/go-holdem/errors/gameerror"
+)
+
+const (
+	TimerStarted  = "timer_started"  // 플레이어의 턴이 시작되거나 타임뱅크로 시간이 늘어남
+	TimerTick     = "timer_tick"     // 남은 시간
+	ActionTimeout = "action_timeout" // 시간이 지나서 자동으로 체크하거나 폴드함 (쇼다운이면 핸드를 버림)
+
+	DefaultTickInterval = time.Second
+)
+
+// TimerEvent는 타이머 상태가 바뀔 때마다 방의 클라이언트들에게 보내는 이벤트
+type TimerEvent struct {
+	Type        string       `json:"type"`
+	RoomId      string       `json:"room_id"`
+	Nickname    string       `json:"nickname"` // 액션해야하는 플레이어
+	Deadline    time.Time    `json:"deadline"`
+	RemainingMs int64        `json:"remaining_ms"`
+	TimeBankMs  int64        `json:"time_bank_ms"`     // 플레이어에게 남은 타임뱅크
+	Result      *BetResponse `json:"result,omitempty"` // ActionTimeout일 때 자동으로 처리된 액션의 결과
+}
+
+// ShotClock은 방마다 현재 플레이어의 액션 마감 시간을 기다리다가
+// 시간이 지나면 GameService.HandleTimeout으로 자동 액션을 처리함
+// 마감 시간은 게임에 저장되므로 타이머 자체는 메모리에만 있어도 됨
+type ShotClock struct {
+	gameService  *GameService
+	publisher    repository.EventPublisher
+	tickInterval time.Duration
+
+	mu     sync.Mutex
+	timers map[string]chan struct{} // roomId별로 실행 중인 타이머를 멈추기 위한 채널
+}
+
+func NewShotClock(gameService *GameService, publisher repository.EventPublisher) *ShotClock {
+	return &ShotClock{
+		gameService:  gameService,
+		publisher:    publisher,
+		tickInterval: DefaultTickInterval,
+		timers:       make(map[string]chan struct{}),
+	}
+}
+
+// Start는 방에서 실행 중인 타이머를 멈추고 게임에 저장된 마감 시간으로 새 타이머를 시작함
+// 마감 시간이 없으면 (시간 제한이 없거나 핸드가 끝난 경우) 타이머를 멈추기만 하고 nil을 리턴함
+func (s *ShotClock) Start(roomId string, game *entity.Game) *TimerEvent {
+	s.Stop(roomId)
+	if !game.IsStarted || game.ActionDeadline.IsZero() {
+		return nil
+	}
+
+	player := game.Players[game.CurrentPlayerIdx]
+	deadline := game.ActionDeadline
+
+	done := make(chan struct{})
+	s.mu.Lock()
+	s.timers[roomId] = done
+	s.mu.Unlock()
+
+	event := s.newEvent(TimerStarted, roomId, player, deadline)
+	s.publish(roomId, event)
+
+	go s.run(roomId, player, deadline, done)
+	return event
+}
+
+// Stop은 방에서 실행 중인 타이머를 멈춤
+func (s *ShotClock) Stop(roomId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if done, ok := s.timers[roomId]; ok {
+		close(done)
+		delete(s.timers, roomId)
+	}
+}
+
+func (s *ShotClock) IsRunning(roomId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.timers[roomId]
+	return ok
+}
+
+func (s *ShotClock) run(roomId string, player *entity.Player, deadline time.Time, done chan struct{}) {
+	ticker := time.NewTicker(s.tickInterval)
+	defer ticker.Stop()
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			s.publish(roomId, s.newEvent(TimerTick, roomId, player, deadline))
+		case <-timer.C:
+			s.mu.Lock()
+			if s.timers[roomId] == done {
+				delete(s.timers, roomId)
+			}
+			s.mu.Unlock()
+
+			// 다음 플레이어의 타이머는 HandleTimeout 안에서 시작됨
+			res, err := s.gameService.HandleTimeout(context.Background(), roomId, deadline)
+			if err != nil {
+				// 그 사이에 플레이어가 액션했으면 이미 새 타이머가 시작되었으므로 무시함
+				if err != gameerror.ActionNotExpired && err != gameerror.StaleGame {
+					fmt.Println("ShotClockErr: ", err.Error())
+				}
+				return
+			}
+
+			event := s.newEvent(ActionTimeout, roomId, player, deadline)
+			event.Result = res
+			s.publish(roomId, event)
+			return
+		}
+	}
+}
+
+func (s *ShotClock) newEvent(eventType string, roomId string, player *entity.Player, deadline time.Time) *TimerEvent {
+	remaining := time.Until(deadline)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &TimerEvent{
+		Type:        eventType,
+		RoomId:      roomId,
+		Nickname:    player.Nickname,
+		Deadline:    deadline,
+		RemainingMs: remaining.Milliseconds(),
+		TimeBankMs:  player.TimeBank.Milliseconds(),
+	}
+}
+
+func (s *ShotClock) publish(roomId string, event *TimerEvent) {
+	if s.publisher == nil {
+		return
+	}
+	if err := s.publisher.Publish(context.Background(), getSubscribeChan(roomId), event); err != nil {
+		fmt.Println("ShotClockPublishErr: ", err.Error())
+	}
+}
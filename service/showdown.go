@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PudgeKim/go-holdem/card"
+	"github.com/PudgeKim/go-holdem/domain/entity"
+)
+
+const ShowdownRevealed = "showdown_revealed" // 쇼다운에서 플레이어들의 핸드가 공개되거나 버려짐
+
+// ShowdownEvent는 쇼다운에서 공개된 핸드를 방의 모든 클라이언트에게 보내는 이벤트
+// 액션한 플레이어는 BetResponse로 결과를 받지만 다른 플레이어들도 공개된 핸드를 봐야하므로 따로 보냄
+type ShowdownEvent struct {
+	Type   string       `json:"type"`
+	RoomId string       `json:"room_id"`
+	Result *BetResponse `json:"result"` // Showdown에 지금까지 공개한 순서대로 핸드가 들어있음 (핸드가 끝났으면 정산 결과까지)
+}
+
+// Decide는 쇼다운에서 핸드를 버릴 수 있는 플레이어가 공개할지 버릴지 정함
+// AutoMuck을 끈 플레이어만 결정할 차례가 오고, 시간 안에 정하지 않으면 HandleTimeout에서 버림
+func (g *GameService) Decide(ctx context.Context, roomId string, nickname string, decision entity.ShowdownDecision) (*BetResponse, error) {
+	defer g.roomLocks.Lock(roomId)()
+
+	game, err := g.GetGame(ctx, roomId)
+	if err != nil {
+		return nil, err
+	}
+	return g.applyDecision(ctx, game, nickname, decision)
+}
+
+func (g *GameService) applyDecision(ctx context.Context, game *entity.Game, nickname string, decision entity.ShowdownDecision) (*BetResponse, error) {
+	phase, board := game.Phase, append([]card.Card(nil), game.Board...)
+
+	result, err := game.Decide(nickname, decision)
+	if err != nil {
+		return nil, err
+	}
+	return g.applyResult(ctx, game, nickname, result, phase, board)
+}
+
+// publishShowdown은 공개된 핸드가 있으면 방에 보냄
+func (g *GameService) publishShowdown(roomId string, betResponse *BetResponse) {
+	if g.publisher == nil || betResponse.Showdown == nil {
+		return
+	}
+
+	event := &ShowdownEvent{
+		Type:   ShowdownRevealed,
+		RoomId: roomId,
+		Result: betResponse,
+	}
+	if err := g.publisher.Publish(context.Background(), getSubscribeChan(roomId), event); err != nil {
+		fmt.Println("ShowdownPublishErr: ", err.Error())
+	}
+}
@@ -33,13 +33,3 @@ func getPlayerIdx(players []*entity.Player, nickname string) (uint, error) {
 	}
 	return 0, gameerror.NoPlayerExists
 }
-
-func getBetType(p *entity.Player, betAmount uint64, game *entity.Game) BetType {
-	if p.GameBalance == p.TotalBet+betAmount {
-		return ALLIN
-	}
-	if game.CurrentBet < p.CurrentBet + betAmount {
-		return RAISE
-	}
-	return CHECK
-}
\ No newline at end of file